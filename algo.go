@@ -1,15 +1,16 @@
 package algo
 
-import "cmp"
+import (
+	"cmp"
+	"slices"
+
+	"github.com/clayessex/algo/seq"
+)
 
 // Create and return a new slice filled with the results of applying function f
-// on every element of s
+// on every element of s. See seq.Map for the lazy form.
 func Map[T any, O any](s []T, f func(T) O) []O {
-	result := make([]O, 0, len(s))
-	for _, v := range s {
-		result = append(result, f(v))
-	}
-	return result
+	return slices.AppendSeq(make([]O, 0, len(s)), seq.Map(slices.Values(s), f))
 }
 
 // Apply an accumulator function f to every element of s and return the final
@@ -17,32 +18,33 @@ func Map[T any, O any](s []T, f func(T) O) []O {
 // subsequent iterations over s. The final result value returned from f is then
 // returned from Reduce.
 func Reduce[T any, O any](s []T, init O, f func(acc O, v T) O) O {
-	result := init
-	for _, v := range s {
-		result = f(result, v)
-	}
-	return result
+	return seq.Reduce(slices.Values(s), init, f)
 }
 
 // Create and return a new slice containing only the elements of s for which f
-// returns true.
+// returns true. See seq.Filter for the lazy form.
 func Filter[T any](s []T, f func(T) bool) []T {
-	result := make([]T, 0)
-	for _, v := range s {
-		if f(v) {
-			result = append(result, v)
-		}
-	}
-	return result
+	return slices.AppendSeq(make([]T, 0), seq.Filter(slices.Values(s), f))
 }
 
-// Create and return a new slice such that s[0, middle) is swapped with
-// s[middle, len(s))
+// Rotate s in place such that s[0, middle) is swapped with s[middle, len(s))
+// and return s. Uses three reversals: O(len(s)) swaps and no allocation.
+// Panics if middle is outside [0, len(s)]
 func Rotate[T any](s []T, middle int) []T {
-	s = append(s[middle:], s[:middle]...)
+	Reverse(s[:middle])
+	Reverse(s[middle:])
+	Reverse(s)
 	return s
 }
 
+// Create and return a new slice holding s[middle, len(s)) followed by
+// s[0, middle). s is left unchanged. Panics if middle is outside [0, len(s)]
+func RotateCopy[T any](s []T, middle int) []T {
+	r := make([]T, 0, len(s))
+	r = append(r, s[middle:]...)
+	return append(r, s[:middle]...)
+}
+
 // Return the number of times that f returns true for each of the elements of s
 func CountFunc[T any](s []T, f func(value T) bool) int {
 	return Reduce(s, 0, func(acc int, v T) int {
@@ -75,10 +77,11 @@ func MergeFunc[T any](a, b []T, comp func(x, y T) bool) []T {
 
 	i, j := 0, 0
 	for i < len(a) && j < len(b) {
-		if comp(a[i], b[j]) {
+		if !comp(b[j], a[i]) {
+			// take the run of a up to b[j], equal elements of a come first
 			k := i
 			for k != len(a) {
-				if !comp(a[k], b[j]) {
+				if comp(b[j], a[k]) {
 					break
 				}
 				k++
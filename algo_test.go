@@ -38,6 +38,21 @@ func TestRotate(t *testing.T) {
 	s := []int{1, 2, 3, 4, 5, 6}
 	s = Rotate(s, 3)
 	expect(t, s, []int{4, 5, 6, 1, 2, 3})
+
+	// in place, the backing array of the input is rotated
+	a := []int{1, 2, 3, 4, 5}
+	Rotate(a, 2)
+	expect(t, a, []int{3, 4, 5, 1, 2})
+	Rotate(a, 0)
+	expect(t, a, []int{3, 4, 5, 1, 2})
+	Rotate(a, 5)
+	expect(t, a, []int{3, 4, 5, 1, 2})
+	expect(t, Rotate([]int{}, 0), []int{})
+
+	a = []int{1, 2, 3, 4, 5}
+	expect(t, RotateCopy(a, 1), []int{2, 3, 4, 5, 1})
+	expect(t, a, []int{1, 2, 3, 4, 5})
+	expect(t, RotateCopy(a[:0], 0), []int{})
 }
 
 func TestCount(t *testing.T) {
@@ -60,6 +75,8 @@ func TestMerge(t *testing.T) {
 		{"5", []int{1, 2, 6, 7}, []int{3, 4}, []int{1, 2, 3, 4, 6, 7}},
 		{"6", []int{1, 2}, []int{3, 4}, []int{1, 2, 3, 4}},
 		{"7", []int{3, 4}, []int{1, 2}, []int{1, 2, 3, 4}},
+		{"8", []int{1, 5, 9}, []int{2, 5, 8}, []int{1, 2, 5, 5, 8, 9}},
+		{"9", []int{2, 2}, []int{2}, []int{2, 2, 2}},
 	}
 
 	for _, v := range data {
@@ -91,3 +108,11 @@ func TestMapValues(t *testing.T) {
 	slices.Sort(values)
 	expect(t, values, []int{1, 2, 3})
 }
+
+func TestMergeFuncStable(t *testing.T) {
+	type pair struct{ k, src int }
+	a := []pair{{1, 0}, {2, 0}, {2, 0}}
+	b := []pair{{2, 1}, {3, 1}}
+	r := MergeFunc(a, b, func(x, y pair) bool { return x.k < y.k })
+	expect(t, r, []pair{{1, 0}, {2, 0}, {2, 0}, {2, 1}, {3, 1}})
+}
@@ -0,0 +1,81 @@
+package algo
+
+import "cmp"
+
+// Return the index of the first element of s for which pred returns false. s
+// must be partitioned so that pred is true for every element before that index
+// and false for every element after it. Returns len(s) if pred is true for
+// every element. O(log n)
+func PartitionPoint[T any](s []T, pred func(v T) bool) int {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if pred(s[mid]) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// Return the index of the first element of the sorted slice s that is not less
+// than value, or len(s) if there is none. Elements are ordered using <
+func LowerBound[T cmp.Ordered](s []T, value T) int {
+	return LowerBoundFunc(s, value, cmp.Less[T])
+}
+
+// Return the index of the first element of the sorted slice s that is not
+// ordered before value, or len(s) if there is none. Elements are ordered using
+// the function comp.
+func LowerBoundFunc[T any](s []T, value T, comp func(a, b T) bool) int {
+	return PartitionPoint(s, func(v T) bool {
+		return comp(v, value)
+	})
+}
+
+// Return the index of the first element of the sorted slice s that is greater
+// than value, or len(s) if there is none. Elements are ordered using <
+func UpperBound[T cmp.Ordered](s []T, value T) int {
+	return UpperBoundFunc(s, value, cmp.Less[T])
+}
+
+// Return the index of the first element of the sorted slice s that value is
+// ordered before, or len(s) if there is none. Elements are ordered using the
+// function comp.
+func UpperBoundFunc[T any](s []T, value T, comp func(a, b T) bool) int {
+	return PartitionPoint(s, func(v T) bool {
+		return !comp(value, v)
+	})
+}
+
+// Return the range [first, last) of the elements of the sorted slice s that
+// are equal to value. When there are none first == last is the position value
+// would be inserted at. Elements are ordered using <
+func EqualRange[T cmp.Ordered](s []T, value T) (int, int) {
+	return EqualRangeFunc(s, value, cmp.Less[T])
+}
+
+// Return the range [first, last) of the elements of the sorted slice s that
+// are equivalent to value. When there are none first == last is the position
+// value would be inserted at. Elements are ordered using the function comp.
+func EqualRangeFunc[T any](s []T, value T, comp func(a, b T) bool) (int, int) {
+	first := LowerBoundFunc(s, value, comp)
+	last := first + UpperBoundFunc(s[first:], value, comp)
+	return first, last
+}
+
+// Search the sorted slice s for value and return the index of the first
+// element equal to it and true, otherwise the position value would be inserted
+// at and false. Elements are ordered using <
+func BinarySearch[T cmp.Ordered](s []T, value T) (int, bool) {
+	return BinarySearchFunc(s, value, cmp.Less[T])
+}
+
+// Search the sorted slice s for value and return the index of the first
+// element equivalent to it and true, otherwise the position value would be
+// inserted at and false. Elements are ordered using the function comp.
+func BinarySearchFunc[T any](s []T, value T, comp func(a, b T) bool) (int, bool) {
+	i := LowerBoundFunc(s, value, comp)
+	return i, i < len(s) && !comp(value, s[i])
+}
@@ -0,0 +1,74 @@
+package algo
+
+import "testing"
+
+func TestPartitionPoint(t *testing.T) {
+	s := []int{1, 3, 5, 2, 4}
+	odd := func(v int) bool { return v%2 == 1 }
+	expect(t, PartitionPoint(s, odd), 3)
+	expect(t, PartitionPoint([]int{}, odd), 0)
+	expect(t, PartitionPoint([]int{1, 3}, odd), 2)
+	expect(t, PartitionPoint([]int{2, 4}, odd), 0)
+}
+
+func TestLowerUpperBound(t *testing.T) {
+	s := []int{1, 2, 2, 2, 5, 7}
+	data := []struct {
+		value int
+		lower int
+		upper int
+	}{
+		{0, 0, 0},
+		{1, 0, 1},
+		{2, 1, 4},
+		{3, 4, 4},
+		{7, 5, 6},
+		{9, 6, 6},
+	}
+	for _, v := range data {
+		expect(t, LowerBound(s, v.value), v.lower)
+		expect(t, UpperBound(s, v.value), v.upper)
+	}
+	expect(t, LowerBound([]int{}, 1), 0)
+	expect(t, UpperBound([]int{}, 1), 0)
+}
+
+func TestBoundFunc(t *testing.T) {
+	s := []string{"ccc", "bb", "bb", "a"} // by descending length
+	longer := func(a, b string) bool { return len(a) > len(b) }
+	expect(t, LowerBoundFunc(s, "xx", longer), 1)
+	expect(t, UpperBoundFunc(s, "xx", longer), 3)
+	first, last := EqualRangeFunc(s, "xx", longer)
+	expect(t, []int{first, last}, []int{1, 3})
+	i, ok := BinarySearchFunc(s, "x", longer)
+	expect(t, i, 3)
+	expect(t, ok, true)
+	i, ok = BinarySearchFunc(s, "xxxx", longer)
+	expect(t, i, 0)
+	expect(t, ok, false)
+}
+
+func TestEqualRange(t *testing.T) {
+	s := []int{1, 2, 2, 2, 5, 7}
+	first, last := EqualRange(s, 2)
+	expect(t, []int{first, last}, []int{1, 4})
+	first, last = EqualRange(s, 4)
+	expect(t, []int{first, last}, []int{4, 4})
+	first, last = EqualRange([]int{}, 4)
+	expect(t, []int{first, last}, []int{0, 0})
+}
+
+func TestBinarySearch(t *testing.T) {
+	s := Merge([]int{1, 5, 9}, []int{2, 5, 8})
+	i, ok := BinarySearch(s, 5)
+	expect(t, i, 2)
+	expect(t, ok, true)
+	i, ok = BinarySearch(s, 6)
+	expect(t, i, 4)
+	expect(t, ok, false)
+	i, ok = BinarySearch(s, 10)
+	expect(t, i, 6)
+	expect(t, ok, false)
+	_, ok = BinarySearch([]int{}, 1)
+	expect(t, ok, false)
+}
@@ -0,0 +1,136 @@
+// Package comparator builds and converts the comparison functions taken by the
+// Func variants throughout this module. Those all use the less style
+// func(a, b T) bool, returning true if a is ordered before b, while the
+// standard slices and maps packages use the three-way style func(a, b T) int.
+// A Comparator can be passed anywhere a less function is expected, and
+// FromCompare and Comparator.Compare convert between the two styles:
+//
+//	algo.MergeFunc(a, b, comparator.FromCompare(strings.Compare))
+//	slices.SortFunc(s, comparator.By(age).ThenBy(comparator.By(name)).Compare)
+package comparator
+
+import "cmp"
+
+// Comparator is a less style comparison: it returns true if a is ordered
+// before b. It must be a strict weak ordering.
+type Comparator[T any] func(a, b T) bool
+
+// Return a Comparator ordering values using <
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Less[T]
+}
+
+// Return a Comparator ordering values by the result of key using <
+func By[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) bool {
+		return key(a) < key(b)
+	}
+}
+
+// Return a Comparator ordering values by the result of key using the function
+// comp
+func ByFunc[T any, K any](key func(T) K, comp func(a, b K) bool) Comparator[T] {
+	return func(a, b T) bool {
+		return comp(key(a), key(b))
+	}
+}
+
+// Return a Comparator for the reverse of the order of comp
+func Reverse[T any](comp func(a, b T) bool) Comparator[T] {
+	return func(a, b T) bool {
+		return comp(b, a)
+	}
+}
+
+// Return a Comparator that orders values using the first of comps that tells
+// them apart. Values are equal only if they are equal under every one.
+func Chain[T any](comps ...func(a, b T) bool) Comparator[T] {
+	return func(a, b T) bool {
+		for _, comp := range comps {
+			if comp(a, b) {
+				return true
+			}
+			if comp(b, a) {
+				return false
+			}
+		}
+		return false
+	}
+}
+
+// Return a Comparator for pointers that orders nil before every non-nil pointer
+// and compares non-nil pointers by the values they point to using the function
+// comp
+func NilsFirst[T any](comp func(a, b T) bool) Comparator[*T] {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return comp(*a, *b)
+	}
+}
+
+// Return a Comparator for pointers that orders nil after every non-nil pointer
+// and compares non-nil pointers by the values they point to using the function
+// comp
+func NilsLast[T any](comp func(a, b T) bool) Comparator[*T] {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return comp(*a, *b)
+	}
+}
+
+// Convert a three-way comparison, which returns a negative number, zero or a
+// positive number when a is less than, equal to or greater than b, into a
+// Comparator
+func FromCompare[T any](compare func(a, b T) int) Comparator[T] {
+	return func(a, b T) bool {
+		return compare(a, b) < 0
+	}
+}
+
+// Convert a less style comparison into a three-way comparison returning -1, 0
+// or +1
+func ToCompare[T any](comp func(a, b T) bool) func(a, b T) int {
+	return Comparator[T](comp).Compare
+}
+
+// Return an equality function treating a and b as equal when neither is
+// ordered before the other by comp, for APIs such as algo.UniqueFunc
+func Equal[T any](comp func(a, b T) bool) func(a, b T) bool {
+	return Comparator[T](comp).Equal
+}
+
+// Return true if a is ordered before b
+func (c Comparator[T]) Less(a, b T) bool {
+	return c(a, b)
+}
+
+// Return -1 if a is ordered before b, +1 if b is ordered before a, otherwise 0.
+// The method value c.Compare can be passed to the slices package.
+func (c Comparator[T]) Compare(a, b T) int {
+	if c(a, b) {
+		return -1
+	}
+	if c(b, a) {
+		return +1
+	}
+	return 0
+}
+
+// Return true if neither a nor b is ordered before the other
+func (c Comparator[T]) Equal(a, b T) bool {
+	return !c(a, b) && !c(b, a)
+}
+
+// Return a Comparator for the reverse of the order of c
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return Reverse(c)
+}
+
+// Return a Comparator that orders values using c and breaks ties using next
+func (c Comparator[T]) ThenBy(next func(a, b T) bool) Comparator[T] {
+	return Chain(c, next)
+}
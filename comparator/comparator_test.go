@@ -0,0 +1,101 @@
+package comparator
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/clayessex/algo"
+	"github.com/clayessex/algo/expected"
+	"github.com/clayessex/algo/vessels"
+)
+
+func expect[T any](t *testing.T, actual T, want T) {
+	t.Helper()
+	expected.Expect(t, actual, want)
+}
+
+type person struct {
+	name string
+	age  int
+}
+
+func name(p person) string { return p.name }
+func age(p person) int     { return p.age }
+
+var people = []person{{"bob", 30}, {"al", 25}, {"cy", 30}, {"al", 20}}
+
+func TestBy(t *testing.T) {
+	s := slices.Clone(people)
+	algo.StableSortFunc(s, By(age))
+	expect(t, s, []person{{"al", 20}, {"al", 25}, {"bob", 30}, {"cy", 30}})
+
+	s = slices.Clone(people)
+	algo.StableSortFunc(s, ByFunc(name, func(a, b string) bool { return len(a) < len(b) }))
+	expect(t, s, []person{{"al", 25}, {"cy", 30}, {"al", 20}, {"bob", 30}})
+
+	expect(t, Natural[int]()(1, 2), true)
+	expect(t, Natural[int]()(2, 2), false)
+}
+
+func TestReverse(t *testing.T) {
+	s := []int{2, 3, 1}
+	algo.StableSortFunc(s, Reverse(Natural[int]()))
+	expect(t, s, []int{3, 2, 1})
+	algo.StableSortFunc(s, Natural[int]().Reverse().Reverse())
+	expect(t, s, []int{1, 2, 3})
+}
+
+func TestThenBy(t *testing.T) {
+	s := slices.Clone(people)
+	algo.StableSortFunc(s, By(name).ThenBy(Reverse(By(age))))
+	expect(t, s, []person{{"al", 25}, {"al", 20}, {"bob", 30}, {"cy", 30}})
+
+	s = slices.Clone(people)
+	algo.StableSortFunc(s, Chain(Reverse(By(age)), By(name)))
+	expect(t, s, []person{{"bob", 30}, {"cy", 30}, {"al", 25}, {"al", 20}})
+
+	expect(t, Chain[int]()(1, 2), false)
+}
+
+func TestNils(t *testing.T) {
+	one, two := 1, 2
+	s := []*int{&two, nil, &one, nil}
+	algo.StableSortFunc(s, NilsFirst(Natural[int]()))
+	expect(t, s, []*int{nil, nil, &one, &two})
+	algo.StableSortFunc(s, NilsLast(Natural[int]()))
+	expect(t, s, []*int{&one, &two, nil, nil})
+	algo.StableSortFunc(s, NilsLast(Reverse(Natural[int]())))
+	expect(t, s, []*int{&two, &one, nil, nil})
+}
+
+func TestConversions(t *testing.T) {
+	less := FromCompare(strings.Compare)
+	expect(t, less("a", "b"), true)
+	expect(t, less("b", "a"), false)
+	expect(t, less("a", "a"), false)
+
+	compare := ToCompare(func(a, b int) bool { return a < b })
+	expect(t, []int{compare(1, 2), compare(2, 1), compare(2, 2)}, []int{-1, 1, 0})
+
+	eq := Equal(By(age))
+	expect(t, eq(person{"x", 30}, person{"y", 30}), true)
+	expect(t, eq(person{"x", 30}, person{"x", 31}), false)
+	expect(t, By(age).Less(people[1], people[0]), true)
+}
+
+// both styles work with the Func APIs of algo and vessels and with slices
+func TestAdapters(t *testing.T) {
+	a, b := []string{"a", "c"}, []string{"b", "d"}
+	expect(t, algo.MergeFunc(a, b, FromCompare(strings.Compare)), []string{"a", "b", "c", "d"})
+	expect(t, algo.UniqueFunc([]int{1, 1, 2}, Equal(Natural[int]())), []int{1, 2})
+
+	m := vessels.NewTreeMapFunc[string, int](FromCompare(strings.Compare).Reverse())
+	m.Insert("a", 1)
+	m.Insert("b", 2)
+	expect(t, m.Keys(), []string{"b", "a"})
+
+	s := slices.Clone(people)
+	slices.SortStableFunc(s, By(age).Compare)
+	expect(t, s, []person{{"al", 20}, {"al", 25}, {"bob", 30}, {"cy", 30}})
+}
@@ -0,0 +1,137 @@
+package algo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// IndexError records the error returned for the element of a slice at Index
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("algo: element %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// Same as Map but f may fail. Stops at the first error and returns the results
+// for the elements before it, the index of the failing element and the error.
+// On success returns all of the results, len(s) and nil.
+func MapErr[T any, O any](s []T, f func(T) (O, error)) ([]O, int, error) {
+	r := make([]O, 0, len(s))
+	for i, v := range s {
+		o, err := f(v)
+		if err != nil {
+			return r, i, err
+		}
+		r = append(r, o)
+	}
+	return r, len(s), nil
+}
+
+// Same as Filter but f may fail. Stops at the first error and returns the
+// elements kept before it, the index of the failing element and the error. On
+// success returns the kept elements, len(s) and nil.
+func FilterErr[T any](s []T, f func(T) (bool, error)) ([]T, int, error) {
+	r := make([]T, 0)
+	for i, v := range s {
+		keep, err := f(v)
+		if err != nil {
+			return r, i, err
+		}
+		if keep {
+			r = append(r, v)
+		}
+	}
+	return r, len(s), nil
+}
+
+// Same as Reduce but f may fail. Stops at the first error and returns the
+// accumulator from before it, the index of the failing element and the error.
+// On success returns the final result, len(s) and nil.
+func ReduceErr[T any, O any](s []T, init O, f func(acc O, v T) (O, error)) (O, int, error) {
+	for i, v := range s {
+		acc, err := f(init, v)
+		if err != nil {
+			return init, i, err
+		}
+		init = acc
+	}
+	return init, len(s), nil
+}
+
+// Same as MapErr but also stops before calling f for an element once ctx is
+// done, returning ctx.Err() and the index of that element. ctx is passed to f.
+func MapCtx[T any, O any](ctx context.Context, s []T, f func(context.Context, T) (O, error)) ([]O, int, error) {
+	return MapErr(s, func(v T) (O, error) {
+		if err := ctx.Err(); err != nil {
+			var zero O
+			return zero, err
+		}
+		return f(ctx, v)
+	})
+}
+
+// Same as FilterErr but also stops before calling f for an element once ctx is
+// done, returning ctx.Err() and the index of that element. ctx is passed to f.
+func FilterCtx[T any](ctx context.Context, s []T, f func(context.Context, T) (bool, error)) ([]T, int, error) {
+	return FilterErr(s, func(v T) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return f(ctx, v)
+	})
+}
+
+// Same as ReduceErr but also stops before calling f for an element once ctx is
+// done, returning ctx.Err() and the index of that element. ctx is passed to f.
+func ReduceCtx[T any, O any](ctx context.Context, s []T, init O, f func(ctx context.Context, acc O, v T) (O, error)) (O, int, error) {
+	return ReduceErr(s, init, func(acc O, v T) (O, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return f(ctx, acc, v)
+	})
+}
+
+// Same as MapErr but calls f for every element instead of stopping at the
+// first error. The result holds a value for every element, the zero value where
+// f failed. Each failure is wrapped in an *IndexError and they are combined
+// with errors.Join, so the error is nil only if every call succeeded.
+func MapErrAll[T any, O any](s []T, f func(T) (O, error)) ([]O, error) {
+	r := make([]O, len(s))
+	var errs []error
+	for i, v := range s {
+		o, err := f(v)
+		if err != nil {
+			errs = append(errs, &IndexError{i, err})
+			continue
+		}
+		r[i] = o
+	}
+	return r, errors.Join(errs...)
+}
+
+// Same as FilterErr but calls f for every element instead of stopping at the
+// first error. Elements where f failed are left out of the result. Each failure
+// is wrapped in an *IndexError and they are combined with errors.Join, so the
+// error is nil only if every call succeeded.
+func FilterErrAll[T any](s []T, f func(T) (bool, error)) ([]T, error) {
+	r := make([]T, 0)
+	var errs []error
+	for i, v := range s {
+		keep, err := f(v)
+		if err != nil {
+			errs = append(errs, &IndexError{i, err})
+		} else if keep {
+			r = append(r, v)
+		}
+	}
+	return r, errors.Join(errs...)
+}
@@ -0,0 +1,115 @@
+package algo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	r, i, err := MapErr([]string{"1", "2", "x", "4"}, strconv.Atoi)
+	expect(t, r, []int{1, 2})
+	expect(t, i, 2)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("%s failed: unexpected error %v", t.Name(), err)
+	}
+
+	r, i, err = MapErr([]string{"1", "2"}, strconv.Atoi)
+	expect(t, r, []int{1, 2})
+	expect(t, i, 2)
+	expect(t, err, nil)
+}
+
+func TestFilterErr(t *testing.T) {
+	errNeg := errors.New("negative")
+	even := func(v int) (bool, error) {
+		if v < 0 {
+			return false, errNeg
+		}
+		return v%2 == 0, nil
+	}
+	r, i, err := FilterErr([]int{1, 2, 3, 4, -5, 6}, even)
+	expect(t, r, []int{2, 4})
+	expect(t, i, 4)
+	expect(t, err, errNeg)
+
+	r, i, err = FilterErr([]int{}, even)
+	expect(t, r, []int{})
+	expect(t, i, 0)
+	expect(t, err, nil)
+}
+
+func TestReduceErr(t *testing.T) {
+	add := func(acc int, v string) (int, error) {
+		n, err := strconv.Atoi(v)
+		return acc + n, err
+	}
+	sum, i, err := ReduceErr([]string{"1", "2", "3"}, 10, add)
+	expect(t, sum, 16)
+	expect(t, i, 3)
+	expect(t, err, nil)
+
+	sum, i, err = ReduceErr([]string{"1", "2", "?", "3"}, 10, add)
+	expect(t, sum, 13)
+	expect(t, i, 2)
+	if err == nil {
+		t.Fatalf("%s failed: expected an error", t.Name())
+	}
+}
+
+func TestCtxVariants(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := []int{1, 2, 3, 4}
+	r, i, err := MapCtx(ctx, s, func(ctx context.Context, v int) (int, error) {
+		if v == 2 {
+			cancel()
+		}
+		return v * 10, nil
+	})
+	expect(t, r, []int{10, 20})
+	expect(t, i, 2)
+	expect(t, err, context.Canceled)
+
+	f, i, err := FilterCtx(ctx, s, func(ctx context.Context, v int) (bool, error) {
+		return true, nil
+	})
+	expect(t, f, []int{})
+	expect(t, i, 0)
+	expect(t, err, context.Canceled)
+
+	sum, i, err := ReduceCtx(context.Background(), s, 0, func(ctx context.Context, acc, v int) (int, error) {
+		return acc + v, nil
+	})
+	expect(t, sum, 10)
+	expect(t, i, 4)
+	expect(t, err, nil)
+}
+
+func TestErrAll(t *testing.T) {
+	r, err := MapErrAll([]string{"1", "x", "3", "y"}, strconv.Atoi)
+	expect(t, r, []int{1, 0, 3, 0})
+	var ie *IndexError
+	if !errors.As(err, &ie) || ie.Index != 1 {
+		t.Fatalf("%s failed: unexpected error %v", t.Name(), err)
+	}
+	expect(t, len(err.(interface{ Unwrap() []error }).Unwrap()), 2)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("%s failed: unexpected error %v", t.Name(), err)
+	}
+
+	r, err = MapErrAll([]string{"1"}, strconv.Atoi)
+	expect(t, r, []int{1})
+	expect(t, err, nil)
+
+	errOdd := errors.New("odd")
+	f, err := FilterErrAll([]int{1, 2, 3, 4}, func(v int) (bool, error) {
+		if v%2 == 1 {
+			return false, errOdd
+		}
+		return v > 2, nil
+	})
+	expect(t, f, []int{4})
+	expect(t, errors.Is(err, errOdd), true)
+	expect(t, err.Error(), "algo: element 0: odd\nalgo: element 2: odd")
+}
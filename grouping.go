@@ -0,0 +1,85 @@
+package algo
+
+import "github.com/clayessex/algo/vessels"
+
+// Group the elements of s by the result of key and return the groups in the
+// order their keys first appear. Each group keeps the order of its elements in
+// s.
+func GroupBy[T any, K comparable](s []T, key func(T) K) *vessels.OrderedMap[K, []T] {
+	m := vessels.NewOrderedMap[K, []T]()
+	for _, v := range s {
+		k := key(v)
+		g, _ := m.Value(k)
+		m.Insert(k, append(g, v))
+	}
+	return m
+}
+
+// Count the elements of s by the result of key and return the counts in the
+// order their keys first appear
+func CountBy[T any, K comparable](s []T, key func(T) K) *vessels.OrderedMap[K, int] {
+	m := vessels.NewOrderedMap[K, int]()
+	for _, v := range s {
+		k := key(v)
+		n, _ := m.Value(k)
+		m.Insert(k, n+1)
+	}
+	return m
+}
+
+// Map each element of s by the result of key, in the order the keys first
+// appear. When more than one element has the same key the last one wins.
+func KeyBy[T any, K comparable](s []T, key func(T) K) *vessels.OrderedMap[K, T] {
+	m := vessels.NewOrderedMap[K, T]()
+	for _, v := range s {
+		m.Insert(key(v), v)
+	}
+	return m
+}
+
+// Create and return two new slices, the elements of s for which f returns true
+// and those for which it returns false, both in their original order. See
+// Partition and StablePartition for the in place forms.
+func PartitionBy[T any](s []T, f func(T) bool) (matched, rest []T) {
+	matched, rest = make([]T, 0), make([]T, 0)
+	for _, v := range s {
+		if f(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+// Split s into consecutive chunks of n elements, the last of which may be
+// shorter. The chunks share the backing array of s but their capacity is
+// limited so that appending to one does not overwrite the next. Panics if n is
+// less than 1.
+func Chunk[T any](s []T, n int) [][]T {
+	if n < 1 {
+		panic("algo: Chunk size must be at least 1")
+	}
+	r := make([][]T, 0, (len(s)+n-1)/n)
+	for i := 0; i < len(s); i += n {
+		j := min(i+n, len(s))
+		r = append(r, s[i:j:j])
+	}
+	return r
+}
+
+// Return every window of n consecutive elements of s, starting at index 0 and
+// advancing by step. Only complete windows are returned, so there are none if
+// len(s) < n, and when step > n the elements between windows are skipped. The
+// windows share the backing array of s with their capacity limited to n. Panics
+// if n or step is less than 1.
+func Window[T any](s []T, n, step int) [][]T {
+	if n < 1 || step < 1 {
+		panic("algo: Window size and step must be at least 1")
+	}
+	r := make([][]T, 0)
+	for i := 0; i+n <= len(s); i += step {
+		r = append(r, s[i:i+n:i+n])
+	}
+	return r
+}
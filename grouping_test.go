@@ -0,0 +1,74 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	words := []string{"bb", "a", "cc", "ddd", "e", "ff"}
+	g := GroupBy(words, func(v string) int { return len(v) })
+	expect(t, g.Keys(), []int{2, 1, 3})
+	expect(t, g.Values(), [][]string{{"bb", "cc", "ff"}, {"a", "e"}, {"ddd"}})
+	expect(t, GroupBy([]string{}, func(v string) int { return len(v) }).Len(), 0)
+}
+
+func TestCountBy(t *testing.T) {
+	c := CountBy([]int{3, 1, 4, 1, 5, 9, 2, 6}, func(v int) bool { return v%2 == 0 })
+	expect(t, c.Keys(), []bool{false, true})
+	expect(t, c.Values(), []int{5, 3})
+}
+
+func TestKeyBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{2, "b"}, {1, "a"}, {2, "c"}}
+	k := KeyBy(users, func(u user) int { return u.id })
+	expect(t, k.Keys(), []int{2, 1})
+	expect(t, k.Values(), []user{{2, "c"}, {1, "a"}})
+}
+
+func TestPartitionBy(t *testing.T) {
+	m, r := PartitionBy([]int{1, 2, 3, 4, 5}, func(v int) bool { return v > 3 })
+	expect(t, m, []int{4, 5})
+	expect(t, r, []int{1, 2, 3})
+	m, r = PartitionBy([]int{}, func(v int) bool { return true })
+	expect(t, m, []int{})
+	expect(t, r, []int{})
+}
+
+func TestChunk(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	c := Chunk(s, 2)
+	expect(t, c, [][]int{{1, 2}, {3, 4}, {5}})
+	expect(t, Chunk(s, 5), [][]int{{1, 2, 3, 4, 5}})
+	expect(t, Chunk(s, 9), [][]int{{1, 2, 3, 4, 5}})
+	expect(t, Chunk([]int{}, 3), [][]int{})
+
+	_ = append(c[0], 99)
+	expect(t, s, []int{1, 2, 3, 4, 5})
+
+	if catchPanic(func() { Chunk(s, 0) }) == nil {
+		t.Fatalf("%s failed: expected a panic", t.Name())
+	}
+}
+
+func TestWindow(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	expect(t, Window(s, 3, 1), [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}})
+	expect(t, Window(s, 2, 2), [][]int{{1, 2}, {3, 4}})
+	expect(t, Window(s, 1, 3), [][]int{{1}, {4}})
+	expect(t, Window(s, 5, 1), [][]int{{1, 2, 3, 4, 5}})
+	expect(t, Window(s, 6, 1), [][]int{})
+
+	w := Window(s, 2, 1)
+	_ = append(w[0], 99)
+	expect(t, s, []int{1, 2, 3, 4, 5})
+	expect(t, slices.Concat(w...), []int{1, 2, 2, 3, 3, 4, 4, 5})
+
+	if catchPanic(func() { Window(s, 1, 0) }) == nil {
+		t.Fatalf("%s failed: expected a panic", t.Name())
+	}
+}
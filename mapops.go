@@ -0,0 +1,109 @@
+package algo
+
+import (
+	"cmp"
+
+	"github.com/clayessex/algo/vessels"
+)
+
+// Create and return a new slice consisting of the keys of map m in ascending
+// order. Keys are ordered using <
+func SortedMapKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return MapKeysSortedFunc(m, cmp.Less[K])
+}
+
+// Create and return a new slice consisting of the keys of map m ordered using
+// the function comp
+func MapKeysSortedFunc[K comparable, V any](m map[K]V, comp func(a, b K) bool) []K {
+	keys := MapKeys(m)
+	StableSortFunc(keys, comp)
+	return keys
+}
+
+// Create and return a new slice of the key/value pairs of map m in ascending
+// key order. Keys are ordered using <
+func MapEntries[K cmp.Ordered, V any](m map[K]V) []Pair[K, V] {
+	return MapEntriesFunc(m, cmp.Less[K])
+}
+
+// Create and return a new slice of the key/value pairs of map m with the keys
+// ordered using the function comp
+func MapEntriesFunc[K comparable, V any](m map[K]V, comp func(a, b K) bool) []Pair[K, V] {
+	keys := MapKeysSortedFunc(m, comp)
+	r := make([]Pair[K, V], len(keys))
+	for i, k := range keys {
+		r[i] = Pair[K, V]{k, m[k]}
+	}
+	return r
+}
+
+// Create and return a new map from the values of m to their keys. When more
+// than one key has the same value, resolve is called with the key already
+// chosen and the next one and its result is kept. Map iteration order is
+// random, so resolve should not depend on the order of its arguments, for
+// example by returning the smaller key.
+func InvertMap[K comparable, V comparable](m map[K]V, resolve func(a, b K) K) map[V]K {
+	r := make(map[V]K, len(m))
+	for k, v := range m {
+		if prev, ok := r[v]; ok {
+			k = resolve(prev, k)
+		}
+		r[v] = k
+	}
+	return r
+}
+
+// Create and return a new map with the keys of m and the results of applying
+// function f on each of its values
+func MapMapValues[K comparable, V any, O any](m map[K]V, f func(V) O) map[K]O {
+	r := make(map[K]O, len(m))
+	for k, v := range m {
+		r[k] = f(v)
+	}
+	return r
+}
+
+// Create and return a new map containing only the entries of m for which f
+// returns true
+func FilterMap[K comparable, V any](m map[K]V, f func(K, V) bool) map[K]V {
+	r := make(map[K]V)
+	for k, v := range m {
+		if f(k, v) {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// Create and return a new map holding the entries of every map in maps. The
+// maps are merged in argument order, and when a key is already present resolve
+// is called with the key, the value so far and the new value, and its result
+// is kept.
+func MergeMaps[K comparable, V any](resolve func(key K, a, b V) V, maps ...map[K]V) map[K]V {
+	r := make(map[K]V)
+	for _, m := range maps {
+		for k, v := range m {
+			if prev, ok := r[k]; ok {
+				v = resolve(k, prev, v)
+			}
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// Create and return a new OrderedMap holding the entries of m in ascending key
+// order. Keys are ordered using <
+func MapToOrderedMap[K cmp.Ordered, V any](m map[K]V) *vessels.OrderedMap[K, V] {
+	return MapToOrderedMapFunc(m, cmp.Less[K])
+}
+
+// Create and return a new OrderedMap holding the entries of m with the keys
+// ordered using the function comp
+func MapToOrderedMapFunc[K comparable, V any](m map[K]V, comp func(a, b K) bool) *vessels.OrderedMap[K, V] {
+	r := vessels.NewOrderedMap[K, V](len(m))
+	for _, k := range MapKeysSortedFunc(m, comp) {
+		r.Insert(k, m[k])
+	}
+	return r
+}
@@ -0,0 +1,72 @@
+package algo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSortedMapKeys(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+	expect(t, SortedMapKeys(m), []string{"a", "b", "c"})
+	expect(t, MapKeysSortedFunc(m, func(a, b string) bool { return a > b }), []string{"c", "b", "a"})
+	expect(t, SortedMapKeys(map[int]int{}), []int{})
+}
+
+func TestMapEntries(t *testing.T) {
+	m := map[int]string{2: "b", 1: "a", 3: "c"}
+	expect(t, MapEntries(m), []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}})
+	expect(t, MapEntriesFunc(m, func(a, b int) bool { return a > b }),
+		[]Pair[int, string]{{3, "c"}, {2, "b"}, {1, "a"}})
+}
+
+func TestInvertMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 1, "d": 1}
+	smaller := func(a, b string) string { return min(a, b) }
+	larger := func(a, b string) string { return max(a, b) }
+	r := InvertMap(m, smaller)
+	expect(t, r, map[int]string{1: "a", 2: "b"})
+	r = InvertMap(m, larger)
+	expect(t, r, map[int]string{1: "d", 2: "b"})
+
+	concat := func(a, b string) string {
+		if a > b {
+			a, b = b, a
+		}
+		return a + b
+	}
+	expect(t, InvertMap(map[string]int{"x": 0, "y": 0}, concat), map[int]string{0: "xy"})
+}
+
+func TestMapMapValues(t *testing.T) {
+	m := map[int]string{1: "a", 2: "bb"}
+	expect(t, MapMapValues(m, strings.ToUpper), map[int]string{1: "A", 2: "BB"})
+	expect(t, MapMapValues(m, func(v string) int { return len(v) }), map[int]int{1: 1, 2: 2})
+}
+
+func TestFilterMap(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c"}
+	r := FilterMap(m, func(k int, v string) bool { return k != 2 })
+	expect(t, r, map[int]string{1: "a", 3: "c"})
+	expect(t, FilterMap(m, func(k int, v string) bool { return false }), map[int]string{})
+}
+
+func TestMergeMaps(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 10, "z": 3}
+	c := map[string]int{"y": 100}
+	sum := func(k string, a, b int) int { return a + b }
+	expect(t, MergeMaps(sum, a, b, c), map[string]int{"x": 1, "y": 112, "z": 3})
+	last := func(k string, a, b int) int { return b }
+	expect(t, MergeMaps(last, a, b), map[string]int{"x": 1, "y": 10, "z": 3})
+	expect(t, MergeMaps(last), map[string]int{})
+	expect(t, a, map[string]int{"x": 1, "y": 2})
+}
+
+func TestMapToOrderedMap(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+	o := MapToOrderedMap(m)
+	expect(t, o.Keys(), []string{"a", "b", "c"})
+	expect(t, o.Values(), []int{1, 2, 3})
+	o = MapToOrderedMapFunc(m, func(a, b string) bool { return a > b })
+	expect(t, o.Keys(), []string{"c", "b", "a"})
+}
@@ -0,0 +1,150 @@
+package algo
+
+import "cmp"
+
+// Return the index of the first smallest element of s unless s is empty, then
+// it returns 0 and false. Elements are ordered using <
+func MinElement[T cmp.Ordered](s []T) (int, bool) {
+	return MinElementFunc(s, cmp.Less[T])
+}
+
+// Return the index of the first element of s that no other element is ordered
+// before unless s is empty, then it returns 0 and false. Elements are ordered
+// using the function comp.
+func MinElementFunc[T any](s []T, comp func(a, b T) bool) (int, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	m := 0
+	for i := 1; i < len(s); i++ {
+		if comp(s[i], s[m]) {
+			m = i
+		}
+	}
+	return m, true
+}
+
+// Return the index of the first largest element of s unless s is empty, then
+// it returns 0 and false. Elements are ordered using <
+func MaxElement[T cmp.Ordered](s []T) (int, bool) {
+	return MaxElementFunc(s, cmp.Less[T])
+}
+
+// Return the index of the first element of s that is not ordered before any
+// other element unless s is empty, then it returns 0 and false. Elements are
+// ordered using the function comp.
+func MaxElementFunc[T any](s []T, comp func(a, b T) bool) (int, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	m := 0
+	for i := 1; i < len(s); i++ {
+		if comp(s[m], s[i]) {
+			m = i
+		}
+	}
+	return m, true
+}
+
+// Return the indexes of the first smallest and the last largest elements of s
+// unless s is empty, then it returns 0, 0 and false. Makes at most 3n/2
+// comparisons. Elements are ordered using <
+func MinMaxElement[T cmp.Ordered](s []T) (lo, hi int, ok bool) {
+	return MinMaxElementFunc(s, cmp.Less[T])
+}
+
+// Return the indexes of the first smallest and the last largest elements of s
+// unless s is empty, then it returns 0, 0 and false. Makes at most 3n/2
+// comparisons. Elements are ordered using the function comp.
+func MinMaxElementFunc[T any](s []T, comp func(a, b T) bool) (lo, hi int, ok bool) {
+	if len(s) == 0 {
+		return 0, 0, false
+	}
+	// compare elements in pairs, the smaller against lo and the larger against hi
+	i := 1
+	if len(s)%2 == 0 {
+		if comp(s[1], s[0]) {
+			lo, hi = 1, 0
+		} else {
+			lo, hi = 0, 1
+		}
+		i = 2
+	}
+	for ; i+1 < len(s); i += 2 {
+		a, b := i, i+1
+		if comp(s[b], s[a]) {
+			a, b = b, a
+		}
+		if comp(s[a], s[lo]) {
+			lo = a
+		}
+		if !comp(s[b], s[hi]) {
+			hi = b
+		}
+	}
+	return lo, hi, true
+}
+
+// Return the first element of s with the smallest key unless s is empty, then
+// it returns a default initialized value and false. key is called once per
+// element. Keys are ordered using <
+func MinBy[T any, K cmp.Ordered](s []T, key func(T) K) (T, bool) {
+	return MinByFunc(s, key, cmp.Less[K])
+}
+
+// Return the first element of s whose key no other key is ordered before
+// unless s is empty, then it returns a default initialized value and false.
+// key is called once per element. Keys are ordered using the function comp.
+func MinByFunc[T any, K any](s []T, key func(T) K, comp func(a, b K) bool) (T, bool) {
+	return extremeBy(s, key, comp)
+}
+
+// Return the first element of s with the largest key unless s is empty, then
+// it returns a default initialized value and false. key is called once per
+// element. Keys are ordered using <
+func MaxBy[T any, K cmp.Ordered](s []T, key func(T) K) (T, bool) {
+	return MaxByFunc(s, key, cmp.Less[K])
+}
+
+// Return the first element of s whose key is not ordered before any other key
+// unless s is empty, then it returns a default initialized value and false.
+// key is called once per element. Keys are ordered using the function comp.
+func MaxByFunc[T any, K any](s []T, key func(T) K, comp func(a, b K) bool) (T, bool) {
+	return extremeBy(s, key, func(a, b K) bool {
+		return comp(b, a)
+	})
+}
+
+// first element of s whose key is not preceded by another using comp
+func extremeBy[T any, K any](s []T, key func(T) K, comp func(a, b K) bool) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	m, mk := 0, key(s[0])
+	for i := 1; i < len(s); i++ {
+		if k := key(s[i]); comp(k, mk) {
+			m, mk = i, k
+		}
+	}
+	return s[m], true
+}
+
+// Create and return a new slice of the indexes of s in the order that would
+// sort s. The sort is stable, so equal elements keep their index order. s is
+// left unchanged. Elements are ordered using <
+func ArgSort[T cmp.Ordered](s []T) []int {
+	return ArgSortFunc(s, cmp.Less[T])
+}
+
+// Create and return a new slice of the indexes of s in the order that would
+// sort s. The sort is stable. s is left unchanged. Elements are ordered using
+// the function comp.
+func ArgSortFunc[T any](s []T, comp func(a, b T) bool) []int {
+	r := make([]int, len(s))
+	Iota(r, 0)
+	StableSortFunc(r, func(a, b int) bool {
+		return comp(s[a], s[b])
+	})
+	return r
+}
@@ -0,0 +1,99 @@
+package algo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMinMaxElement(t *testing.T) {
+	s := []int{3, 1, 4, 1, 5, 9, 2, 6, 9}
+	i, ok := MinElement(s)
+	expect(t, i, 1)
+	expect(t, ok, true)
+	i, ok = MaxElement(s)
+	expect(t, i, 5)
+	expect(t, ok, true)
+	lo, hi, ok := MinMaxElement(s)
+	expect(t, lo, 1)
+	expect(t, hi, 8)
+	expect(t, ok, true)
+
+	_, ok = MinElement([]int{})
+	expect(t, ok, false)
+	_, ok = MaxElement([]int{})
+	expect(t, ok, false)
+	_, _, ok = MinMaxElement([]int{})
+	expect(t, ok, false)
+
+	lo, hi, _ = MinMaxElement([]int{7})
+	expect(t, []int{lo, hi}, []int{0, 0})
+	lo, hi, _ = MinMaxElement([]int{2, 2})
+	expect(t, []int{lo, hi}, []int{0, 1})
+	lo, hi, _ = MinMaxElement([]int{2, 1})
+	expect(t, []int{lo, hi}, []int{1, 0})
+
+	gt := func(a, b string) bool { return a > b }
+	w := []string{"b", "c", "a"}
+	i, _ = MinElementFunc(w, gt)
+	expect(t, i, 1)
+	i, _ = MaxElementFunc(w, gt)
+	expect(t, i, 2)
+	lo, hi, _ = MinMaxElementFunc(w, gt)
+	expect(t, []int{lo, hi}, []int{1, 2})
+}
+
+func TestMinMaxElementRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for n := 1; n < 40; n++ {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(5)
+		}
+		lo, hi, _ := MinMaxElement(s)
+		wantLo, _ := MinElement(s)
+		wantHi := 0
+		for i, v := range s {
+			if v >= s[wantHi] {
+				wantHi = i
+			}
+		}
+		expect(t, lo, wantLo)
+		expect(t, hi, wantHi)
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	words := []string{"ccc", "a", "bb", "d", "eee"}
+	length := func(v string) int { return len(v) }
+	v, ok := MinBy(words, length)
+	expect(t, v, "a")
+	expect(t, ok, true)
+	v, _ = MaxBy(words, length)
+	expect(t, v, "ccc")
+	v, ok = MinBy([]string{}, length)
+	expect(t, v, "")
+	expect(t, ok, false)
+
+	calls := 0
+	counted := func(v string) int {
+		calls++
+		return len(v)
+	}
+	MaxBy(words, counted)
+	expect(t, calls, len(words))
+
+	gt := func(a, b int) bool { return a > b }
+	v, _ = MinByFunc(words, length, gt)
+	expect(t, v, "ccc")
+	v, ok = MaxByFunc(words, length, gt)
+	expect(t, v, "a")
+	expect(t, ok, true)
+}
+
+func TestArgSort(t *testing.T) {
+	s := []int{30, 10, 20, 10}
+	expect(t, ArgSort(s), []int{1, 3, 2, 0})
+	expect(t, s, []int{30, 10, 20, 10})
+	expect(t, ArgSortFunc(s, func(a, b int) bool { return a > b }), []int{0, 2, 1, 3})
+	expect(t, ArgSort([]int{}), []int{})
+}
@@ -0,0 +1,122 @@
+package algo
+
+// In place algorithms that rearrange or overwrite the elements of a slice. None
+// of them allocate except StablePartition.
+
+// Reverse the order of the elements of s in place
+func Reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Reorder s in place so that every element for which f returns true comes
+// before every element for which it returns false and return the number of
+// elements for which it returned true. The relative order within each group is
+// not kept; see StablePartition. Does not allocate.
+func Partition[T any](s []T, f func(T) bool) int {
+	i, j := 0, len(s)-1
+	for {
+		for i <= j && f(s[i]) {
+			i++
+		}
+		for i <= j && !f(s[j]) {
+			j--
+		}
+		if i > j {
+			return i
+		}
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+}
+
+// Same as Partition but keeps the relative order of the elements within each
+// group. Allocates a buffer for the elements for which f returns false.
+func StablePartition[T any](s []T, f func(T) bool) int {
+	var rejected []T
+	k := 0
+	for _, v := range s {
+		if f(v) {
+			s[k] = v
+			k++
+		} else {
+			rejected = append(rejected, v)
+		}
+	}
+	copy(s[k:], rejected)
+	return k
+}
+
+// Replace every run of equal consecutive elements of s with its first element
+// and return the shortened slice, which shares the backing array of s. The
+// elements between the new and old lengths are zeroed. Does not allocate.
+func Unique[T comparable](s []T) []T {
+	return UniqueFunc(s, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// Same as Unique but elements a and b are equal if eq(a, b) returns true. eq is
+// called with the first element of the current run and the next element.
+func UniqueFunc[T any](s []T, eq func(a, b T) bool) []T {
+	if len(s) < 2 {
+		return s
+	}
+	k := 1
+	for i := 1; i < len(s); i++ {
+		if !eq(s[k-1], s[i]) {
+			s[k] = s[i]
+			k++
+		}
+	}
+	clear(s[k:])
+	return s[:k]
+}
+
+// Remove every element of s for which f returns true, keeping the order of the
+// rest, and return the shortened slice, which shares the backing array of s.
+// The elements between the new and old lengths are zeroed. Does not allocate.
+func RemoveIf[T any](s []T, f func(T) bool) []T {
+	k := 0
+	for _, v := range s {
+		if !f(v) {
+			s[k] = v
+			k++
+		}
+	}
+	clear(s[k:])
+	return s[:k]
+}
+
+// Replace every element of s equal to old with new, in place
+func Replace[T comparable](s []T, old, new T) {
+	ReplaceIf(s, func(v T) bool {
+		return v == old
+	}, new)
+}
+
+// Replace every element of s for which f returns true with new, in place
+func ReplaceIf[T any](s []T, f func(T) bool, new T) {
+	for i, v := range s {
+		if f(v) {
+			s[i] = new
+		}
+	}
+}
+
+// Set every element of s to v
+func Fill[T any](s []T, v T) {
+	for i := range s {
+		s[i] = v
+	}
+}
+
+// Set the elements of s to the results of successive calls to f, from first to
+// last
+func Generate[T any](s []T, f func() T) {
+	for i := range s {
+		s[i] = f()
+	}
+}
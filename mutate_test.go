@@ -0,0 +1,92 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	Reverse(s)
+	expect(t, s, []int{4, 3, 2, 1})
+	s = []int{1, 2, 3}
+	Reverse(s)
+	expect(t, s, []int{3, 2, 1})
+	Reverse([]int{})
+}
+
+func TestPartition(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	data := [][]int{
+		{},
+		{1},
+		{2},
+		{1, 3, 5},
+		{2, 4, 6},
+		{1, 2, 3, 4, 5, 6, 7, 8},
+		{8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	for _, v := range data {
+		s := slices.Clone(v)
+		k := Partition(s, even)
+		expect(t, k, CountFunc(v, even))
+		for i, x := range s {
+			expect(t, even(x), i < k)
+		}
+		want := slices.Clone(v)
+		slices.Sort(want)
+		slices.Sort(s)
+		expect(t, s, want)
+	}
+}
+
+func TestStablePartition(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	k := StablePartition(s, func(v int) bool { return v%3 == 0 })
+	expect(t, k, 2)
+	expect(t, s, []int{3, 6, 1, 2, 4, 5, 7, 8})
+	expect(t, StablePartition([]int{}, func(v int) bool { return true }), 0)
+}
+
+func TestUnique(t *testing.T) {
+	s := []int{1, 1, 2, 2, 2, 1, 3, 3}
+	u := Unique(s)
+	expect(t, u, []int{1, 2, 1, 3})
+	expect(t, s[4:], []int{0, 0, 0, 0})
+	expect(t, Unique([]int{}), []int{})
+	expect(t, Unique([]int{5}), []int{5})
+
+	// runs of values within 1 of the first element of the run
+	near := func(a, b int) bool { return b-a <= 1 }
+	expect(t, UniqueFunc([]int{1, 2, 3, 4, 6, 7}, near), []int{1, 3, 6})
+}
+
+func TestRemoveIf(t *testing.T) {
+	s := []string{"a", "", "b", "", "c"}
+	r := RemoveIf(s, func(v string) bool { return v == "" })
+	expect(t, r, []string{"a", "b", "c"})
+	expect(t, s[3:], []string{"", ""})
+	expect(t, RemoveIf([]int{1, 2}, func(v int) bool { return true }), []int{})
+}
+
+func TestReplace(t *testing.T) {
+	s := []int{1, 2, 1, 3}
+	Replace(s, 1, 9)
+	expect(t, s, []int{9, 2, 9, 3})
+	ReplaceIf(s, func(v int) bool { return v < 5 }, 0)
+	expect(t, s, []int{9, 0, 9, 0})
+}
+
+func TestFillGenerate(t *testing.T) {
+	s := make([]string, 3)
+	Fill(s, "x")
+	expect(t, s, []string{"x", "x", "x"})
+
+	n := 0
+	g := make([]int, 4)
+	Generate(g, func() int {
+		n += 2
+		return n
+	})
+	expect(t, g, []int{2, 4, 6, 8})
+}
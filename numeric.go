@@ -0,0 +1,198 @@
+package algo
+
+import "errors"
+
+// Returned by the checked numeric functions when an integer result does not fit
+// in its type
+var ErrOverflow = errors.New("algo: integer overflow")
+
+// Integer is a constraint for any integer type
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Number is a constraint for any integer or floating point type
+type Number interface {
+	Integer | ~float32 | ~float64
+}
+
+// Return the sum of the elements of s, 0 if s is empty. Integer overflow wraps;
+// see SumChecked
+func Sum[T Number](s []T) T {
+	return Accumulate(s, 0)
+}
+
+// Return init plus the sum of the elements of s, added in order. Integer
+// overflow wraps; see AccumulateChecked
+func Accumulate[T Number](s []T, init T) T {
+	for _, v := range s {
+		init += v
+	}
+	return init
+}
+
+// Create and return a new slice where element i is the sum of s[0] through
+// s[i]. Integer overflow wraps; see PartialSumChecked
+func PartialSum[T Number](s []T) []T {
+	return InclusiveScan(s, func(a, b T) T {
+		return a + b
+	})
+}
+
+// Create and return a new slice where element i is the result of combining
+// s[0] through s[i] with f, from left to right. Element 0 is s[0].
+func InclusiveScan[T any](s []T, f func(acc, v T) T) []T {
+	r := make([]T, len(s))
+	for i, v := range s {
+		if i > 0 {
+			v = f(r[i-1], v)
+		}
+		r[i] = v
+	}
+	return r
+}
+
+// Create and return a new slice where element i is the result of combining init
+// and s[0] through s[i-1] with f, from left to right. Element 0 is init and
+// s[len(s)-1] does not contribute.
+func ExclusiveScan[T any, O any](s []T, init O, f func(acc O, v T) O) []O {
+	r := make([]O, len(s))
+	for i, v := range s {
+		r[i] = init
+		init = f(init, v)
+	}
+	return r
+}
+
+// Create and return a new slice where element 0 is s[0] and element i is
+// s[i] - s[i-1]. Integer overflow wraps; see AdjacentDifferenceChecked
+func AdjacentDifference[T Number](s []T) []T {
+	r := make([]T, len(s))
+	for i, v := range s {
+		if i > 0 {
+			v -= s[i-1]
+		}
+		r[i] = v
+	}
+	return r
+}
+
+// Return init plus the sum of a[i] * b[i]. Only the first min(len(a), len(b))
+// elements are used. Integer overflow wraps; see InnerProductChecked
+func InnerProduct[T Number](a, b []T, init T) T {
+	for i := range min(len(a), len(b)) {
+		init += a[i] * b[i]
+	}
+	return init
+}
+
+// Fill s with sequentially increasing values starting with start
+func Iota[T Number](s []T, start T) {
+	for i := range s {
+		s[i] = start
+		start++
+	}
+}
+
+// Return a + b or ErrOverflow
+func addChecked[T Integer](a, b T) (T, error) {
+	r := a + b
+	if (b > 0 && r < a) || (b < 0 && r > a) {
+		return r, ErrOverflow
+	}
+	return r, nil
+}
+
+// Return a - b or ErrOverflow
+func subChecked[T Integer](a, b T) (T, error) {
+	r := a - b
+	if (b > 0 && r > a) || (b < 0 && r < a) {
+		return r, ErrOverflow
+	}
+	return r, nil
+}
+
+// Return a * b or ErrOverflow
+func mulChecked[T Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	r := a * b
+	var zero T
+	if a < zero && zero-1 < zero && a == zero-1 {
+		// the only overflow is -min, which wraps back to min
+		if r == b {
+			return r, ErrOverflow
+		}
+		return r, nil
+	}
+	if r/a != b {
+		return r, ErrOverflow
+	}
+	return r, nil
+}
+
+// Same as Sum but returns ErrOverflow if the result does not fit in T
+func SumChecked[T Integer](s []T) (T, error) {
+	return AccumulateChecked(s, 0)
+}
+
+// Same as Accumulate but returns ErrOverflow if any intermediate sum does not
+// fit in T
+func AccumulateChecked[T Integer](s []T, init T) (T, error) {
+	var err error
+	for _, v := range s {
+		if init, err = addChecked(init, v); err != nil {
+			return 0, err
+		}
+	}
+	return init, nil
+}
+
+// Same as PartialSum but returns a nil slice and ErrOverflow if any sum does
+// not fit in T
+func PartialSumChecked[T Integer](s []T) ([]T, error) {
+	r := make([]T, len(s))
+	var err error
+	for i, v := range s {
+		if i > 0 {
+			if v, err = addChecked(r[i-1], v); err != nil {
+				return nil, err
+			}
+		}
+		r[i] = v
+	}
+	return r, nil
+}
+
+// Same as AdjacentDifference but returns a nil slice and ErrOverflow if any
+// difference does not fit in T
+func AdjacentDifferenceChecked[T Integer](s []T) ([]T, error) {
+	r := make([]T, len(s))
+	var err error
+	for i, v := range s {
+		if i > 0 {
+			if v, err = subChecked(v, s[i-1]); err != nil {
+				return nil, err
+			}
+		}
+		r[i] = v
+	}
+	return r, nil
+}
+
+// Same as InnerProduct but returns ErrOverflow if any product or intermediate
+// sum does not fit in T
+func InnerProductChecked[T Integer](a, b []T, init T) (T, error) {
+	for i := range min(len(a), len(b)) {
+		p, err := mulChecked(a[i], b[i])
+		if err != nil {
+			return 0, err
+		}
+		if init, err = addChecked(init, p); err != nil {
+			return 0, err
+		}
+	}
+	return init, nil
+}
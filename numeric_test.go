@@ -0,0 +1,101 @@
+package algo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	expect(t, Sum([]int{}), 0)
+	expect(t, Sum([]int{1, 2, 3, 4}), 10)
+	expect(t, Sum([]float64{0.5, 0.25}), 0.75)
+	expect(t, Accumulate([]int{1, 2, 3}, 10), 16)
+}
+
+func TestScans(t *testing.T) {
+	expect(t, PartialSum([]int{1, 2, 3, 4}), []int{1, 3, 6, 10})
+	expect(t, PartialSum([]int{}), []int{})
+
+	mul := func(a, b int) int { return a * b }
+	expect(t, InclusiveScan([]int{1, 2, 3, 4}, mul), []int{1, 2, 6, 24})
+	expect(t, ExclusiveScan([]int{1, 2, 3, 4}, 1, mul), []int{1, 1, 2, 6})
+	expect(t, ExclusiveScan([]int{}, 1, mul), []int{})
+
+	length := func(acc int, v string) int { return acc + len(v) }
+	expect(t, ExclusiveScan([]string{"ab", "c", "def"}, 0, length), []int{0, 2, 3})
+}
+
+func TestAdjacentDifference(t *testing.T) {
+	expect(t, AdjacentDifference([]int{2, 4, 7, 7, 1}), []int{2, 2, 3, 0, -6})
+	expect(t, AdjacentDifference([]int{}), []int{})
+	s := []int{5, 1, 8, 3}
+	expect(t, PartialSum(AdjacentDifference(s)), s)
+}
+
+func TestInnerProduct(t *testing.T) {
+	expect(t, InnerProduct([]int{1, 2, 3}, []int{4, 5, 6}, 0), 32)
+	expect(t, InnerProduct([]int{1, 2, 3}, []int{4}, 1), 5)
+	expect(t, InnerProduct([]float64{}, []float64{}, 2), 2.0)
+}
+
+func TestIota(t *testing.T) {
+	s := make([]int, 4)
+	Iota(s, -1)
+	expect(t, s, []int{-1, 0, 1, 2})
+	f := make([]float64, 3)
+	Iota(f, 0.5)
+	expect(t, f, []float64{0.5, 1.5, 2.5})
+}
+
+func TestNumericChecked(t *testing.T) {
+	x, err := SumChecked([]int8{100, 27})
+	expect(t, x, int8(127))
+	expect(t, err, nil)
+	_, err = SumChecked([]int8{100, 28})
+	expect(t, err, ErrOverflow)
+	_, err = SumChecked([]int8{-100, -29})
+	expect(t, err, ErrOverflow)
+	_, err = AccumulateChecked([]uint8{10}, 250)
+	expect(t, err, ErrOverflow)
+	x, err = AccumulateChecked([]int8{100, 100, -100}, -100)
+	expect(t, x, int8(0))
+	expect(t, err, nil)
+
+	p, err := PartialSumChecked([]int64{1, math.MaxInt64 - 1})
+	expect(t, p, []int64{1, math.MaxInt64})
+	expect(t, err, nil)
+	p, err = PartialSumChecked([]int64{1, math.MaxInt64})
+	expect(t, p, nil)
+	expect(t, err, ErrOverflow)
+
+	d, err := AdjacentDifferenceChecked([]uint{5, 7, 9})
+	expect(t, d, []uint{5, 2, 2})
+	expect(t, err, nil)
+	_, err = AdjacentDifferenceChecked([]uint{5, 3})
+	expect(t, err, ErrOverflow)
+	_, err = AdjacentDifferenceChecked([]int8{100, -100})
+	expect(t, err, ErrOverflow)
+
+	data := []struct {
+		a, b int8
+		err  error
+	}{
+		{64, 2, ErrOverflow},
+		{-64, 2, nil},
+		{-128, -1, ErrOverflow},
+		{-1, -128, ErrOverflow},
+		{-1, 127, nil},
+		{11, 11, nil},
+		{12, 11, ErrOverflow},
+		{0, -128, nil},
+	}
+	for _, v := range data {
+		_, err := InnerProductChecked([]int8{v.a}, []int8{v.b}, 0)
+		expect(t, err, v.err)
+	}
+	_, err = InnerProductChecked([]uint16{256}, []uint16{256}, 0)
+	expect(t, err, ErrOverflow)
+	y, err := InnerProductChecked([]int{1, 2, 3}, []int{4, 5, 6}, 0)
+	expect(t, y, 32)
+	expect(t, err, nil)
+}
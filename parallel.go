@@ -0,0 +1,155 @@
+package algo
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Call body for every index in [0, n) using at most limit goroutines, or
+// GOMAXPROCS goroutines if limit is less than 1. Indexes are handed out in
+// increasing order. After the first error or panic, or once ctx is done, no
+// further indexes are started. A panic is re-raised in the calling goroutine
+// with the same value once every worker has stopped. Otherwise the first error
+// is returned, or ctx.Err() if ctx ended the loop early.
+func parallelFor(ctx context.Context, n, limit int, body func(i int) error) error {
+	if limit < 1 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	limit = min(limit, n)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next     atomic.Int64
+		once     sync.Once
+		firstErr error
+		panicked bool
+		panicVal any
+		wg       sync.WaitGroup
+	)
+	fail := func(err error, isPanic bool, v any) {
+		once.Do(func() {
+			firstErr, panicked, panicVal = err, isPanic, v
+			cancel()
+		})
+	}
+	worker := func() {
+		defer wg.Done()
+		defer func() {
+			if v := recover(); v != nil {
+				fail(nil, true, v)
+			}
+		}()
+		for ctx.Err() == nil {
+			i := int(next.Add(1) - 1)
+			if i >= n {
+				return
+			}
+			if err := body(i); err != nil {
+				fail(err, false, nil)
+				return
+			}
+		}
+	}
+
+	wg.Add(limit)
+	for range limit {
+		go worker()
+	}
+	wg.Wait()
+
+	if panicked {
+		panic(panicVal)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if int(next.Load()) < n {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// Call f with the index and value of every element of s using at most limit
+// goroutines, or GOMAXPROCS if limit is less than 1. Elements are started in
+// order but may finish in any order. Stops starting new calls after the first
+// error or panic, or when ctx is done. A panic in f is re-raised in the calling
+// goroutine, otherwise the first error or ctx.Err() is returned.
+func ParallelForEach[T any](ctx context.Context, s []T, limit int, f func(i int, v T) error) error {
+	return parallelFor(ctx, len(s), limit, func(i int) error {
+		return f(i, s[i])
+	})
+}
+
+// Create and return a new slice filled with the results of applying f on every
+// element of s, computed by at most limit goroutines, or GOMAXPROCS if limit is
+// less than 1. The result is in the same order as s. On the first error or
+// panic, or when ctx is done, no further calls are started and a nil slice is
+// returned with the error; a panic in f is re-raised in the calling goroutine.
+func ParallelMap[T any, O any](ctx context.Context, s []T, limit int, f func(T) (O, error)) ([]O, error) {
+	r := make([]O, len(s))
+	err := parallelFor(ctx, len(s), limit, func(i int) (err error) {
+		r[i], err = f(s[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Create and return a new slice containing only the elements of s for which f
+// returns true, in their original order. f is called by at most limit
+// goroutines, or GOMAXPROCS if limit is less than 1. Errors, panics and
+// cancellation are handled the same as ParallelMap.
+func ParallelFilter[T any](ctx context.Context, s []T, limit int, f func(T) (bool, error)) ([]T, error) {
+	keep, err := ParallelMap(ctx, s, limit, f)
+	if err != nil {
+		return nil, err
+	}
+	r := make([]T, 0)
+	for i, v := range s {
+		if keep[i] {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
+// Combine init and every element of s with f, where f must be associative but
+// need not be commutative: the result is the same as f(...f(f(init, s[0]),
+// s[1])..., s[n-1]). s is split into contiguous chunks that are reduced by at
+// most limit goroutines, or GOMAXPROCS if limit is less than 1, and the chunk
+// results are then combined in order. A panic in f is re-raised in the calling
+// goroutine. If ctx is done before every chunk is reduced, init and ctx.Err()
+// are returned.
+func ParallelReduce[T any](ctx context.Context, s []T, limit int, init T, f func(a, b T) T) (T, error) {
+	if limit < 1 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	chunks := min(limit, len(s))
+	if chunks == 0 {
+		return init, nil
+	}
+	size := (len(s) + chunks - 1) / chunks
+	chunks = (len(s) + size - 1) / size
+	partial := make([]T, chunks)
+	err := parallelFor(ctx, chunks, limit, func(i int) error {
+		c := s[i*size : min((i+1)*size, len(s))]
+		acc := c[0]
+		for _, v := range c[1:] {
+			acc = f(acc, v)
+		}
+		partial[i] = acc
+		return nil
+	})
+	if err != nil {
+		return init, err
+	}
+	for _, v := range partial {
+		init = f(init, v)
+	}
+	return init, nil
+}
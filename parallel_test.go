@@ -0,0 +1,154 @@
+package algo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// run f and return the value it panics with, nil if it does not
+func catchPanic(f func()) (v any) {
+	defer func() {
+		v = recover()
+	}()
+	f()
+	return nil
+}
+
+func TestParallelMap(t *testing.T) {
+	ctx := context.Background()
+	s := make([]int, 1000)
+	Iota(s, 0)
+	r, err := ParallelMap(ctx, s, 8, func(v int) (string, error) {
+		return strconv.Itoa(v * 2), nil
+	})
+	expect(t, err, nil)
+	expect(t, r, Map(s, func(v int) string { return strconv.Itoa(v * 2) }))
+
+	r, err = ParallelMap(ctx, []int{}, 0, func(v int) (string, error) {
+		return "", nil
+	})
+	expect(t, err, nil)
+	expect(t, r, []string{})
+}
+
+func TestParallelLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	s := make([]int, 50)
+	err := ParallelForEach(context.Background(), s, 3, func(i int, v int) error {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return nil
+	})
+	expect(t, err, nil)
+	if peak.Load() > 3 {
+		t.Fatalf("%s failed: %d goroutines ran at once", t.Name(), peak.Load())
+	}
+}
+
+func TestParallelError(t *testing.T) {
+	errBad := errors.New("bad")
+	var calls atomic.Int32
+	s := make([]int, 10000)
+	Iota(s, 0)
+	r, err := ParallelMap(context.Background(), s, 4, func(v int) (int, error) {
+		calls.Add(1)
+		if v == 10 {
+			return 0, errBad
+		}
+		return v, nil
+	})
+	expect(t, r, nil)
+	expect(t, err, errBad)
+	if calls.Load() == int32(len(s)) {
+		t.Fatalf("%s failed: work continued after the error", t.Name())
+	}
+
+	err = ParallelForEach(context.Background(), s, 4, func(i int, v int) error {
+		if i%100 == 99 {
+			return errBad
+		}
+		return nil
+	})
+	expect(t, err, errBad)
+}
+
+func TestParallelPanic(t *testing.T) {
+	s := make([]int, 100)
+	Iota(s, 0)
+	v := catchPanic(func() {
+		ParallelForEach(context.Background(), s, 4, func(i int, v int) error {
+			if v == 50 {
+				panic("boom")
+			}
+			return nil
+		})
+	})
+	expect[any](t, v, "boom")
+
+	v = catchPanic(func() {
+		ParallelReduce(context.Background(), s, 4, 0, func(a, b int) int {
+			panic("reduce")
+		})
+	})
+	expect[any](t, v, "reduce")
+}
+
+func TestParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := make([]int, 10000)
+	var calls atomic.Int32
+	r, err := ParallelFilter(ctx, s, 2, func(v int) (bool, error) {
+		if calls.Add(1) == 5 {
+			cancel()
+		}
+		return true, nil
+	})
+	expect(t, r, nil)
+	expect(t, err, context.Canceled)
+
+	_, err = ParallelReduce(ctx, s, 2, 0, func(a, b int) int { return a + b })
+	expect(t, err, context.Canceled)
+}
+
+func TestParallelFilter(t *testing.T) {
+	s := make([]int, 1001)
+	Iota(s, 0)
+	odd := func(v int) bool { return v%2 == 1 }
+	r, err := ParallelFilter(context.Background(), s, 0, func(v int) (bool, error) {
+		return odd(v), nil
+	})
+	expect(t, err, nil)
+	expect(t, r, Filter(s, odd))
+}
+
+func TestParallelReduce(t *testing.T) {
+	ctx := context.Background()
+	s := make([]int, 1001)
+	Iota(s, 1)
+	for _, limit := range []int{0, 1, 3, 7, 2000} {
+		sum, err := ParallelReduce(ctx, s, limit, 0, func(a, b int) int { return a + b })
+		expect(t, err, nil)
+		expect(t, sum, 1001*1002/2)
+	}
+
+	// associative but not commutative
+	words := []string{"a", "b", "c", "d", "e", "f", "g"}
+	cat, err := ParallelReduce(ctx, words, 3, ">", func(a, b string) string { return a + b })
+	expect(t, err, nil)
+	expect(t, cat, ">abcdefg")
+
+	x, err := ParallelReduce(ctx, []int{}, 4, 42, func(a, b int) int { return a + b })
+	expect(t, x, 42)
+	expect(t, err, nil)
+}
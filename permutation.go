@@ -0,0 +1,196 @@
+package algo
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Rearrange s into the next lexicographically greater permutation and return
+// true. If s is already the greatest permutation it is rearranged into the
+// smallest, which is sorted, and false is returned. Equal elements are not
+// distinguished, so repeated calls visit each distinct permutation once.
+// Elements are ordered using <
+func NextPermutation[T cmp.Ordered](s []T) bool {
+	return NextPermutationFunc(s, cmp.Less[T])
+}
+
+// Rearrange s into the next permutation in the lexicographic order defined by
+// comp and return true. If s is already the greatest permutation it is
+// rearranged into the smallest and false is returned. Elements are ordered
+// using the function comp.
+func NextPermutationFunc[T any](s []T, comp func(a, b T) bool) bool {
+	i := len(s) - 1
+	for i > 0 && !comp(s[i-1], s[i]) {
+		i--
+	}
+	if i <= 0 {
+		Reverse(s)
+		return false
+	}
+	j := len(s) - 1
+	for !comp(s[i-1], s[j]) {
+		j--
+	}
+	s[i-1], s[j] = s[j], s[i-1]
+	Reverse(s[i:])
+	return true
+}
+
+// Rearrange s into the previous lexicographically smaller permutation and
+// return true. If s is already the smallest permutation, which is sorted, it is
+// rearranged into the greatest and false is returned. Elements are ordered
+// using <
+func PrevPermutation[T cmp.Ordered](s []T) bool {
+	return PrevPermutationFunc(s, cmp.Less[T])
+}
+
+// Rearrange s into the previous permutation in the lexicographic order defined
+// by comp and return true. If s is already the smallest permutation it is
+// rearranged into the greatest and false is returned. Elements are ordered
+// using the function comp.
+func PrevPermutationFunc[T any](s []T, comp func(a, b T) bool) bool {
+	return NextPermutationFunc(s, func(a, b T) bool {
+		return comp(b, a)
+	})
+}
+
+// Return an iterator over every distinct permutation of the elements of s in
+// lexicographic order, starting with the sorted one. s is left unchanged and
+// each permutation is yielded as a new slice. Elements are ordered using <
+func Permutations[T cmp.Ordered](s []T) iter.Seq[[]T] {
+	return PermutationsFunc(s, cmp.Less[T])
+}
+
+// Return an iterator over every distinct permutation of the elements of s in
+// the lexicographic order defined by comp. Elements that are neither ordered
+// before nor after each other are treated as equal. s is left unchanged and
+// each permutation is yielded as a new slice. Elements are ordered using the
+// function comp.
+func PermutationsFunc[T any](s []T, comp func(a, b T) bool) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		p := slices.Clone(s)
+		StableSortFunc(p, comp)
+		for {
+			if !yield(slices.Clone(p)) || !NextPermutationFunc(p, comp) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator over every distinct way to choose k of the elements of s,
+// ignoring order. Each combination is sorted, yielded as a new slice, and they
+// arrive in lexicographic order. Nothing is yielded if k is negative or greater
+// than len(s), a single empty slice if k is 0. Elements are ordered using <
+func Combinations[T cmp.Ordered](s []T, k int) iter.Seq[[]T] {
+	return CombinationsFunc(s, k, cmp.Less[T])
+}
+
+// Return an iterator over every distinct way to choose k of the elements of s,
+// ignoring order. Each combination is sorted by comp, yielded as a new slice,
+// and they arrive in lexicographic order. Elements are ordered using the
+// function comp.
+func CombinationsFunc[T any](s []T, k int, comp func(a, b T) bool) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(s) {
+			return
+		}
+		sorted := slices.Clone(s)
+		StableSortFunc(sorted, comp)
+		c := make([]T, k)
+		var choose func(start, depth int) bool
+		choose = func(start, depth int) bool {
+			if depth == k {
+				return yield(slices.Clone(c))
+			}
+			for i := start; i <= len(sorted)-(k-depth); i++ {
+				if i > start && !comp(sorted[i-1], sorted[i]) {
+					continue // the same choice was made for an equal element
+				}
+				c[depth] = sorted[i]
+				if !choose(i+1, depth+1) {
+					return false
+				}
+			}
+			return true
+		}
+		choose(0, 0)
+	}
+}
+
+// Return an iterator over every distinct way to choose k elements from the
+// distinct values of s when each may be chosen any number of times. Each
+// combination is sorted, yielded as a new slice, and they arrive in
+// lexicographic order. Nothing is yielded if k is negative, or if s is empty
+// and k is not 0. Elements are ordered using <
+func CombinationsWithReplacement[T cmp.Ordered](s []T, k int) iter.Seq[[]T] {
+	return CombinationsWithReplacementFunc(s, k, cmp.Less[T])
+}
+
+// Return an iterator over every distinct way to choose k elements from the
+// distinct values of s when each may be chosen any number of times. Each
+// combination is sorted by comp, yielded as a new slice, and they arrive in
+// lexicographic order. Elements are ordered using the function comp.
+func CombinationsWithReplacementFunc[T any](s []T, k int, comp func(a, b T) bool) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || (k > 0 && len(s) == 0) {
+			return
+		}
+		values := slices.Clone(s)
+		StableSortFunc(values, comp)
+		values = slices.CompactFunc(values, func(a, b T) bool {
+			return !comp(a, b) && !comp(b, a)
+		})
+		c := make([]T, k)
+		var choose func(start, depth int) bool
+		choose = func(start, depth int) bool {
+			if depth == k {
+				return yield(slices.Clone(c))
+			}
+			for i := start; i < len(values); i++ {
+				c[depth] = values[i]
+				if !choose(i, depth+1) {
+					return false
+				}
+			}
+			return true
+		}
+		choose(0, 0)
+	}
+}
+
+// Return an iterator over the cartesian product of the slices: every tuple
+// holding one element from each slice in argument order. Tuples are yielded as
+// new slices with the last position varying fastest. The product of no slices
+// is a single empty tuple and the product including an empty slice is empty.
+func CartesianProduct[T any](s ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, v := range s {
+			if len(v) == 0 {
+				return
+			}
+		}
+		index := make([]int, len(s))
+		for {
+			t := make([]T, len(s))
+			for i, j := range index {
+				t[i] = s[i][j]
+			}
+			if !yield(t) {
+				return
+			}
+			i := len(index) - 1
+			for ; i >= 0; i-- {
+				index[i]++
+				if index[i] < len(s[i]) {
+					break
+				}
+				index[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNextPermutation(t *testing.T) {
+	s := []int{1, 2, 3}
+	var got [][]int
+	for {
+		got = append(got, slices.Clone(s))
+		if !NextPermutation(s) {
+			break
+		}
+	}
+	expect(t, got, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}})
+	expect(t, s, []int{1, 2, 3})
+
+	s = []int{1, 1, 2}
+	expect(t, NextPermutation(s), true)
+	expect(t, s, []int{1, 2, 1})
+	expect(t, NextPermutation(s), true)
+	expect(t, s, []int{2, 1, 1})
+	expect(t, NextPermutation(s), false)
+	expect(t, s, []int{1, 1, 2})
+
+	expect(t, NextPermutation([]int{}), false)
+	expect(t, NextPermutation([]int{7}), false)
+
+	s = []int{1, 2, 3}
+	expect(t, NextPermutationFunc(s, func(a, b int) bool { return a > b }), false)
+	expect(t, s, []int{3, 2, 1})
+}
+
+func TestPrevPermutation(t *testing.T) {
+	s := []int{2, 1, 3}
+	expect(t, PrevPermutation(s), true)
+	expect(t, s, []int{1, 3, 2})
+	expect(t, PrevPermutation(s), true)
+	expect(t, s, []int{1, 2, 3})
+	expect(t, PrevPermutation(s), false)
+	expect(t, s, []int{3, 2, 1})
+
+	r := []string{"a", "b"}
+	expect(t, PrevPermutationFunc(r, func(a, b string) bool { return a > b }), true)
+	expect(t, r, []string{"b", "a"})
+}
+
+func TestPermutations(t *testing.T) {
+	s := []int{2, 1, 2}
+	got := slices.Collect(Permutations(s))
+	expect(t, got, [][]int{{1, 2, 2}, {2, 1, 2}, {2, 2, 1}})
+	expect(t, s, []int{2, 1, 2})
+
+	expect(t, len(slices.Collect(Permutations([]int{1, 2, 3, 4, 5}))), 120)
+	expect(t, slices.Collect(Permutations([]int{})), [][]int{{}})
+
+	got = slices.Collect(PermutationsFunc([]int{1, 2}, func(a, b int) bool { return a > b }))
+	expect(t, got, [][]int{{2, 1}, {1, 2}})
+
+	for p := range Permutations([]int{1, 2, 3}) {
+		expect(t, p, []int{1, 2, 3})
+		break
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	got := slices.Collect(Combinations([]int{4, 3, 2, 1}, 2))
+	expect(t, got, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}})
+
+	got = slices.Collect(Combinations([]int{1, 2, 1, 1}, 2))
+	expect(t, got, [][]int{{1, 1}, {1, 2}})
+
+	expect(t, slices.Collect(Combinations([]int{1, 2}, 0)), [][]int{{}})
+	expect(t, len(slices.Collect(Combinations([]int{1, 2}, 3))), 0)
+	expect(t, len(slices.Collect(Combinations([]int{1, 2}, -1))), 0)
+	expect(t, len(slices.Collect(Combinations([]int{1, 2, 3, 4, 5, 6}, 3))), 20)
+
+	got = slices.Collect(CombinationsFunc([]int{1, 2, 3}, 2, func(a, b int) bool { return a > b }))
+	expect(t, got, [][]int{{3, 2}, {3, 1}, {2, 1}})
+
+	for c := range Combinations([]int{1, 2, 3}, 1) {
+		expect(t, c, []int{1})
+		break
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	got := slices.Collect(CombinationsWithReplacement([]int{2, 1, 2}, 2))
+	expect(t, got, [][]int{{1, 1}, {1, 2}, {2, 2}})
+
+	expect(t, len(slices.Collect(CombinationsWithReplacement([]int{1, 2, 3}, 3))), 10)
+	expect(t, slices.Collect(CombinationsWithReplacement([]int{}, 0)), [][]int{{}})
+	expect(t, len(slices.Collect(CombinationsWithReplacement([]int{}, 1))), 0)
+
+	strs := slices.Collect(CombinationsWithReplacementFunc([]string{"a", "b"}, 2, func(a, b string) bool { return a > b }))
+	expect(t, strs, [][]string{{"b", "b"}, {"b", "a"}, {"a", "a"}})
+}
+
+func TestCartesianProduct(t *testing.T) {
+	got := slices.Collect(CartesianProduct([]int{1, 2}, []int{3}, []int{4, 5}))
+	expect(t, got, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}})
+
+	expect(t, slices.Collect(CartesianProduct[int]()), [][]int{{}})
+	expect(t, len(slices.Collect(CartesianProduct([]int{1}, []int{}))), 0)
+
+	n := 0
+	for range CartesianProduct([]int{1, 2, 3}, []int{1, 2, 3}) {
+		n++
+		if n == 4 {
+			break
+		}
+	}
+	expect(t, n, 4)
+}
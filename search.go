@@ -0,0 +1,220 @@
+package algo
+
+// Return the index of the first element of s equal to v unless there is none,
+// then it returns -1 and false
+func Find[T comparable](s []T, v T) (int, bool) {
+	return FindIf(s, func(e T) bool {
+		return e == v
+	})
+}
+
+// Return the index of the first element of s for which f returns true unless
+// there is none, then it returns -1 and false
+func FindIf[T any](s []T, f func(T) bool) (int, bool) {
+	for i, v := range s {
+		if f(v) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Return the index of the first element of s for which f returns false unless
+// there is none, then it returns -1 and false
+func FindIfNot[T any](s []T, f func(T) bool) (int, bool) {
+	return FindIf(s, func(v T) bool {
+		return !f(v)
+	})
+}
+
+// Return the index of the first occurrence of needle as a contiguous
+// subsequence of haystack unless there is none, then it returns -1 and false.
+// An empty needle is found at index 0. Uses Knuth-Morris-Pratt: O(len(haystack)
+// + len(needle)) comparisons and a table of len(needle) ints.
+func Search[T comparable](haystack, needle []T) (int, bool) {
+	return SearchFunc(haystack, needle, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// Same as Search but elements are equal if eq returns true. eq must be an
+// equivalence relation for the Knuth-Morris-Pratt table to be valid.
+func SearchFunc[T any](haystack, needle []T, eq func(a, b T) bool) (int, bool) {
+	i := -1
+	kmpMatches(haystack, needle, eq, func(end int) bool {
+		i = end - len(needle)
+		return false
+	})
+	return i, i >= 0
+}
+
+// Return the index of the start of the last occurrence of needle as a
+// contiguous subsequence of haystack unless there is none, then it returns -1
+// and false. An empty needle is found at len(haystack). O(len(haystack) +
+// len(needle)) comparisons.
+func FindEnd[T comparable](haystack, needle []T) (int, bool) {
+	return FindEndFunc(haystack, needle, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// Same as FindEnd but elements are equal if eq returns true. eq must be an
+// equivalence relation.
+func FindEndFunc[T any](haystack, needle []T, eq func(a, b T) bool) (int, bool) {
+	if len(needle) == 0 {
+		return len(haystack), true
+	}
+	i := -1
+	kmpMatches(haystack, needle, eq, func(end int) bool {
+		i = end - len(needle)
+		return true
+	})
+	return i, i >= 0
+}
+
+// Call found with the index just past every match of needle in haystack,
+// including overlapping ones, until found returns false
+func kmpMatches[T any](haystack, needle []T, eq func(a, b T) bool, found func(end int) bool) {
+	if len(needle) == 0 {
+		found(0)
+		return
+	}
+	// fail[i] is the length of the longest proper prefix of needle[:i+1] that
+	// is also a suffix of it
+	fail := make([]int, len(needle))
+	for i, k := 1, 0; i < len(needle); i++ {
+		for k > 0 && !eq(needle[i], needle[k]) {
+			k = fail[k-1]
+		}
+		if eq(needle[i], needle[k]) {
+			k++
+		}
+		fail[i] = k
+	}
+	k := 0
+	for i, v := range haystack {
+		for k > 0 && !eq(v, needle[k]) {
+			k = fail[k-1]
+		}
+		if eq(v, needle[k]) {
+			k++
+		}
+		if k == len(needle) {
+			if !found(i + 1) {
+				return
+			}
+			k = fail[k-1]
+		}
+	}
+}
+
+// Return the index of the first run of n consecutive elements of s equal to v
+// unless there is none, then it returns -1 and false. A run of n <= 0 is found
+// at index 0.
+func SearchN[T comparable](s []T, n int, v T) (int, bool) {
+	return SearchNFunc(s, n, func(e T) bool {
+		return e == v
+	})
+}
+
+// Return the index of the first run of n consecutive elements of s for which f
+// returns true unless there is none, then it returns -1 and false. A run of
+// n <= 0 is found at index 0.
+func SearchNFunc[T any](s []T, n int, f func(T) bool) (int, bool) {
+	if n <= 0 {
+		return 0, true
+	}
+	run := 0
+	for i, v := range s {
+		if !f(v) {
+			run = 0
+			continue
+		}
+		run++
+		if run == n {
+			return i - n + 1, true
+		}
+	}
+	return -1, false
+}
+
+// Return the index of the first element of s that is equal to any element of
+// set unless there is none, then it returns -1 and false
+func FindFirstOf[T comparable](s, set []T) (int, bool) {
+	m := make(map[T]struct{}, len(set))
+	for _, v := range set {
+		m[v] = struct{}{}
+	}
+	return FindIf(s, func(v T) bool {
+		_, ok := m[v]
+		return ok
+	})
+}
+
+// Same as FindFirstOf but elements are equal if eq returns true. Makes up to
+// len(s) * len(set) comparisons.
+func FindFirstOfFunc[T any](s, set []T, eq func(a, b T) bool) (int, bool) {
+	return FindIf(s, func(v T) bool {
+		for _, e := range set {
+			if eq(v, e) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Return the first index at which a and b differ, or the length of the shorter
+// one if it is a prefix of the other
+func Mismatch[T comparable](a, b []T) int {
+	return MismatchFunc(a, b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// Same as Mismatch but elements are equal if eq returns true
+func MismatchFunc[T any](a, b []T, eq func(x, y T) bool) int {
+	n := min(len(a), len(b))
+	for i := range n {
+		if !eq(a[i], b[i]) {
+			return i
+		}
+	}
+	return n
+}
+
+// Return the index of the first element of s that is equal to the element
+// after it unless there is none, then it returns -1 and false
+func AdjacentFind[T comparable](s []T) (int, bool) {
+	return AdjacentFindFunc(s, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// Return the first index i for which eq(s[i], s[i+1]) returns true unless there
+// is none, then it returns -1 and false
+func AdjacentFindFunc[T any](s []T, eq func(a, b T) bool) (int, bool) {
+	for i := 1; i < len(s); i++ {
+		if eq(s[i-1], s[i]) {
+			return i - 1, true
+		}
+	}
+	return -1, false
+}
+
+// Return true if f returns true for every element of s, or if s is empty
+func AllOf[T any](s []T, f func(T) bool) bool {
+	_, found := FindIfNot(s, f)
+	return !found
+}
+
+// Return true if f returns true for at least one element of s
+func AnyOf[T any](s []T, f func(T) bool) bool {
+	_, found := FindIf(s, f)
+	return found
+}
+
+// Return true if f returns false for every element of s, or if s is empty
+func NoneOf[T any](s []T, f func(T) bool) bool {
+	return !AnyOf(s, f)
+}
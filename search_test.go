@@ -0,0 +1,151 @@
+package algo
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	s := []int{4, 2, 7, 2}
+	i, ok := Find(s, 2)
+	expect(t, i, 1)
+	expect(t, ok, true)
+	i, ok = Find(s, 9)
+	expect(t, i, -1)
+	expect(t, ok, false)
+
+	i, ok = FindIf(s, func(v int) bool { return v > 5 })
+	expect(t, i, 2)
+	expect(t, ok, true)
+	i, ok = FindIfNot(s, func(v int) bool { return v%2 == 0 })
+	expect(t, i, 2)
+	expect(t, ok, true)
+	_, ok = FindIfNot(s, func(v int) bool { return v < 10 })
+	expect(t, ok, false)
+	_, ok = FindIf([]int{}, func(v int) bool { return true })
+	expect(t, ok, false)
+}
+
+func TestSearch(t *testing.T) {
+	data := []struct {
+		haystack, needle string
+		first, last      int
+	}{
+		{"abcabcabd", "abcabd", 3, 3},
+		{"aaaa", "aa", 0, 2},
+		{"abc", "", 0, 3},
+		{"", "", 0, 0},
+		{"", "a", -1, -1},
+		{"abc", "abcd", -1, -1},
+		{"abababa", "aba", 0, 4},
+		{"xyz", "z", 2, 2},
+	}
+	for _, v := range data {
+		h, n := []byte(v.haystack), []byte(v.needle)
+		i, ok := Search(h, n)
+		expect(t, i, v.first)
+		expect(t, ok, v.first >= 0)
+		i, ok = FindEnd(h, n)
+		expect(t, i, v.last)
+		expect(t, ok, v.last >= 0)
+	}
+
+	fold := func(a, b string) bool { return strings.EqualFold(a, b) }
+	i, ok := SearchFunc([]string{"x", "A", "b"}, []string{"a", "B"}, fold)
+	expect(t, i, 1)
+	expect(t, ok, true)
+	i, _ = FindEndFunc([]string{"a", "A", "b"}, []string{"a"}, fold)
+	expect(t, i, 1)
+}
+
+func TestSearchRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for range 500 {
+		h := make([]byte, r.Intn(30))
+		n := make([]byte, 1+r.Intn(4))
+		for i := range h {
+			h[i] = 'a' + byte(r.Intn(2))
+		}
+		for i := range n {
+			n[i] = 'a' + byte(r.Intn(2))
+		}
+		i, _ := Search(h, n)
+		expect(t, i, strings.Index(string(h), string(n)))
+		i, _ = FindEnd(h, n)
+		expect(t, i, strings.LastIndex(string(h), string(n)))
+	}
+}
+
+func TestSearchN(t *testing.T) {
+	s := []int{1, 2, 2, 3, 2, 2, 2, 4}
+	i, ok := SearchN(s, 3, 2)
+	expect(t, i, 4)
+	expect(t, ok, true)
+	i, _ = SearchN(s, 2, 2)
+	expect(t, i, 1)
+	i, ok = SearchN(s, 4, 2)
+	expect(t, i, -1)
+	expect(t, ok, false)
+	i, ok = SearchN(s, 0, 9)
+	expect(t, i, 0)
+	expect(t, ok, true)
+
+	i, _ = SearchNFunc(s, 2, func(v int) bool { return v > 2 })
+	expect(t, i, -1)
+	i, _ = SearchNFunc(s, 2, func(v int) bool { return v != 1 })
+	expect(t, i, 1)
+}
+
+func TestFindFirstOf(t *testing.T) {
+	s := []rune("hello, world")
+	i, ok := FindFirstOf(s, []rune(" ,"))
+	expect(t, i, 5)
+	expect(t, ok, true)
+	_, ok = FindFirstOf(s, []rune("xyz"))
+	expect(t, ok, false)
+	_, ok = FindFirstOf(s, []rune{})
+	expect(t, ok, false)
+
+	fold := func(a, b string) bool { return strings.EqualFold(a, b) }
+	i, _ = FindFirstOfFunc([]string{"a", "B", "c"}, []string{"C", "b"}, fold)
+	expect(t, i, 1)
+}
+
+func TestMismatch(t *testing.T) {
+	expect(t, Mismatch([]int{1, 2, 3}, []int{1, 2, 4}), 2)
+	expect(t, Mismatch([]int{1, 2}, []int{1, 2, 3}), 2)
+	expect(t, Mismatch([]int{1, 2}, []int{1, 2}), 2)
+	expect(t, Mismatch([]int{}, []int{1}), 0)
+	expect(t, MismatchFunc([]int{1, 3, 5}, []int{11, 13, 16}, func(a, b int) bool {
+		return a%10 == b%10
+	}), 2)
+}
+
+func TestAdjacentFind(t *testing.T) {
+	i, ok := AdjacentFind([]int{1, 2, 3, 3, 4, 4})
+	expect(t, i, 2)
+	expect(t, ok, true)
+	i, ok = AdjacentFind([]int{1, 2, 1})
+	expect(t, i, -1)
+	expect(t, ok, false)
+	_, ok = AdjacentFind([]int{})
+	expect(t, ok, false)
+	i, _ = AdjacentFindFunc([]int{1, 2, 5, 4}, func(a, b int) bool { return b < a })
+	expect(t, i, 2)
+}
+
+func TestAllAnyNoneOf(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	expect(t, AllOf([]int{2, 4}, even), true)
+	expect(t, AllOf([]int{2, 3}, even), false)
+	expect(t, AllOf([]int{}, even), true)
+	expect(t, AnyOf([]int{1, 4}, even), true)
+	expect(t, AnyOf([]int{1, 3}, even), false)
+	expect(t, AnyOf([]int{}, even), false)
+	expect(t, NoneOf([]int{1, 3}, even), true)
+	expect(t, NoneOf([]int{1, 2}, even), false)
+	expect(t, NoneOf([]int{}, even), true)
+	expect(t, slices.ContainsFunc([]int{1, 2}, even), AnyOf([]int{1, 2}, even))
+}
@@ -0,0 +1,225 @@
+package algo
+
+import (
+	"cmp"
+	"iter"
+	"math/bits"
+)
+
+// Rearrange s so that s[n] holds the element that would be there if s were
+// sorted, every element before it is not greater and every element after it is
+// not less. Uses introselect: quickselect with a median of three pivot that
+// switches to a heap select if the partitioning stops making progress, so the
+// average cost is O(len(s)) and the worst case is O(len(s) log len(s))
+// comparisons. Does nothing if n is out of range. Elements are ordered using <
+func NthElement[T cmp.Ordered](s []T, n int) {
+	NthElementFunc(s, n, cmp.Less[T])
+}
+
+// Rearrange s so that s[n] holds the element that would be there if s were
+// sorted, no element before it is ordered after it and no element after it is
+// ordered before it. Average O(len(s)), worst case O(len(s) log len(s))
+// comparisons. Does nothing if n is out of range. Elements are ordered using
+// the function comp.
+func NthElementFunc[T any](s []T, n int, comp func(a, b T) bool) {
+	if n < 0 || n >= len(s) {
+		return
+	}
+	lo, hi := 0, len(s)
+	depth := 2 * bits.Len(uint(len(s)))
+	for hi-lo > 8 {
+		if depth == 0 {
+			PartialSortFunc(s[lo:hi], n-lo+1, comp)
+			return
+		}
+		depth--
+		p := lo + partitionPivot(s[lo:hi], comp)
+		if p == n {
+			return
+		} else if n < p {
+			hi = p
+		} else {
+			lo = p + 1
+		}
+	}
+	insertionSort(s[lo:hi], comp)
+}
+
+// Sort the k smallest elements of s into s[:k]. The order of the remaining
+// elements is unspecified. O(len(s) log k) comparisons in the worst case. k is
+// clamped to [0, len(s)]. Elements are ordered using <
+func PartialSort[T cmp.Ordered](s []T, k int) {
+	PartialSortFunc(s, k, cmp.Less[T])
+}
+
+// Sort the first k elements in comp order into s[:k]. The order of the
+// remaining elements is unspecified. O(len(s) log k) comparisons in the worst
+// case. k is clamped to [0, len(s)]. Elements are ordered using the function
+// comp.
+func PartialSortFunc[T any](s []T, k int, comp func(a, b T) bool) {
+	k = Clamp(k, 0, len(s))
+	if k == 0 {
+		return
+	}
+	heap := s[:k]
+	makeHeap(heap, comp)
+	for i := k; i < len(s); i++ {
+		if comp(s[i], heap[0]) {
+			heap[0], s[i] = s[i], heap[0]
+			siftDown(heap, 0, comp)
+		}
+	}
+	sortHeap(heap, comp)
+}
+
+// Create and return a new sorted slice of the k smallest elements of s, which
+// is left unchanged. O(len(s) log k) comparisons in the worst case. k is
+// clamped to [0, len(s)]. Elements are ordered using <
+func PartialSortCopy[T cmp.Ordered](s []T, k int) []T {
+	return PartialSortCopyFunc(s, k, cmp.Less[T])
+}
+
+// Create and return a new sorted slice of the first k elements of s in comp
+// order, s is left unchanged. O(len(s) log k) comparisons in the worst case. k
+// is clamped to [0, len(s)]. Elements are ordered using the function comp.
+func PartialSortCopyFunc[T any](s []T, k int, comp func(a, b T) bool) []T {
+	k = Clamp(k, 0, len(s))
+	r := make([]T, k)
+	copy(r, s)
+	if k == 0 {
+		return r
+	}
+	makeHeap(r, comp)
+	for _, v := range s[k:] {
+		if comp(v, r[0]) {
+			r[0] = v
+			siftDown(r, 0, comp)
+		}
+	}
+	sortHeap(r, comp)
+	return r
+}
+
+// Create and return a sorted slice of the k smallest values of seq. Only k
+// values are held at a time, so seq may be arbitrarily long. O(n log k)
+// comparisons in the worst case for n values. Elements are ordered using <
+func TopK[T cmp.Ordered](seq iter.Seq[T], k int) []T {
+	return TopKFunc(seq, k, cmp.Less[T])
+}
+
+// Create and return a sorted slice of the first k values of seq in comp order.
+// Only k values are held at a time, so seq may be arbitrarily long. O(n log k)
+// comparisons in the worst case for n values. Elements are ordered using the
+// function comp.
+func TopKFunc[T any](seq iter.Seq[T], k int, comp func(a, b T) bool) []T {
+	k = max(k, 0)
+	r := make([]T, 0, min(k, 1024))
+	if k == 0 {
+		return r
+	}
+	for v := range seq {
+		if len(r) < k {
+			r = append(r, v)
+			siftUp(r, len(r)-1, comp)
+		} else if comp(v, r[0]) {
+			r[0] = v
+			siftDown(r, 0, comp)
+		}
+	}
+	sortHeap(r, comp)
+	return r
+}
+
+// Partition s around a median of three pivot and return the final index p of
+// the pivot such that no element of s[:p] is ordered after it and no element of
+// s[p+1:] is ordered before it. len(s) must be at least 3
+func partitionPivot[T any](s []T, comp func(a, b T) bool) int {
+	last := len(s) - 1
+	mid := len(s) / 2
+	// order s[0], s[mid], s[last] then move the median to the front
+	if comp(s[mid], s[0]) {
+		s[mid], s[0] = s[0], s[mid]
+	}
+	if comp(s[last], s[mid]) {
+		s[last], s[mid] = s[mid], s[last]
+		if comp(s[mid], s[0]) {
+			s[mid], s[0] = s[0], s[mid]
+		}
+	}
+	s[0], s[mid] = s[mid], s[0]
+
+	pivot := s[0]
+	i, j := 1, last
+	for {
+		for i <= j && comp(s[i], pivot) {
+			i++
+		}
+		for i <= j && comp(pivot, s[j]) {
+			j--
+		}
+		if i >= j {
+			break
+		}
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+	s[0], s[j] = s[j], s[0]
+	return j
+}
+
+// Simple insertion sort for short slices
+func insertionSort[T any](s []T, comp func(a, b T) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && comp(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// Max-heap helpers where no parent is ordered before either of its children
+
+// Arrange s into a heap
+func makeHeap[T any](s []T, comp func(a, b T) bool) {
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, comp)
+	}
+}
+
+// Move s[i] toward the root until its parent is not ordered before it
+func siftUp[T any](s []T, i int, comp func(a, b T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !comp(s[parent], s[i]) {
+			break
+		}
+		s[parent], s[i] = s[i], s[parent]
+		i = parent
+	}
+}
+
+// Move s[i] down until neither of its children is ordered after it
+func siftDown[T any](s []T, i int, comp func(a, b T) bool) {
+	for {
+		c := 2*i + 1
+		if c >= len(s) {
+			return
+		}
+		if c+1 < len(s) && comp(s[c], s[c+1]) {
+			c++
+		}
+		if !comp(s[i], s[c]) {
+			return
+		}
+		s[i], s[c] = s[c], s[i]
+		i = c
+	}
+}
+
+// Sort a heap in place by repeatedly moving its root to the end
+func sortHeap[T any](s []T, comp func(a, b T) bool) {
+	for n := len(s) - 1; n > 0; n-- {
+		s[0], s[n] = s[n], s[0]
+		siftDown(s[:n], 0, comp)
+	}
+}
@@ -0,0 +1,180 @@
+package algo
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// McIlroy's "killer adversary" for quicksort. Values are decided lazily as the
+// algorithm compares them so that every pivot choice is as bad as possible.
+// Returns a comparison function over indexes and a counter of its calls.
+func adversary(n int) ([]int, func(a, b int) bool, *int) {
+	gas := n
+	val := make([]int, n)
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+		val[i] = gas
+	}
+	solid, candidate, count := 0, 0, 0
+	comp := func(a, b int) bool {
+		count++
+		if val[a] == gas && val[b] == gas {
+			if a == candidate {
+				val[a] = solid
+			} else {
+				val[b] = solid
+			}
+			solid++
+		}
+		if val[a] == gas {
+			candidate = a
+		} else if val[b] == gas {
+			candidate = b
+		}
+		return val[a] < val[b]
+	}
+	return s, comp, &count
+}
+
+func nlogn(n int) int {
+	return int(float64(n) * math.Log2(float64(n)))
+}
+
+func testInputs(r *rand.Rand, n int) map[string][]int {
+	random := make([]int, n)
+	few := make([]int, n)
+	sorted := make([]int, n)
+	reversed := make([]int, n)
+	organ := make([]int, n)
+	for i := range random {
+		random[i] = r.Intn(n)
+		few[i] = r.Intn(3)
+		sorted[i] = i
+		reversed[i] = n - i
+		organ[i] = min(i, n-i)
+	}
+	return map[string][]int{
+		"random":   random,
+		"few":      few,
+		"sorted":   sorted,
+		"reversed": reversed,
+		"organ":    organ,
+		"equal":    make([]int, n),
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{1, 2, 5, 9, 50, 1000} {
+		for name, s := range testInputs(r, size) {
+			sorted := slices.Clone(s)
+			slices.Sort(sorted)
+			for _, n := range []int{0, size / 3, size / 2, size - 1} {
+				c := slices.Clone(s)
+				NthElement(c, n)
+				if c[n] != sorted[n] {
+					t.Fatalf("%s %s/%d: nth %d = %v, want %v", t.Name(), name, size, n, c[n], sorted[n])
+				}
+				for i := range c {
+					if i < n && c[i] > c[n] || i > n && c[i] < c[n] {
+						t.Fatalf("%s %s/%d: not partitioned at %d", t.Name(), name, size, i)
+					}
+				}
+			}
+		}
+	}
+	s := []int{3, 1, 2}
+	NthElement(s, 3) // out of range
+	NthElement(s, -1)
+	expect(t, s, []int{3, 1, 2})
+}
+
+func TestNthElementFunc(t *testing.T) {
+	s := []string{"ccc", "a", "dddd", "bb", "eeeee"}
+	NthElementFunc(s, 1, func(a, b string) bool { return len(a) > len(b) })
+	expect(t, s[1], "dddd")
+}
+
+func TestNthElementWorstCase(t *testing.T) {
+	const n = 4096
+	s, comp, count := adversary(n)
+	NthElementFunc(s, n/2, comp)
+	if *count > 4*nlogn(n) {
+		t.Fatalf("%s failed: %d comparisons for %d elements", t.Name(), *count, n)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for name, s := range testInputs(r, 500) {
+		sorted := slices.Clone(s)
+		slices.Sort(sorted)
+		for _, k := range []int{0, 1, 10, 499, 500} {
+			c := slices.Clone(s)
+			PartialSort(c, k)
+			if !slices.Equal(c[:k], sorted[:k]) {
+				t.Fatalf("%s %s: k=%d not sorted", t.Name(), name, k)
+			}
+			slices.Sort(c)
+			expect(t, c, sorted) // a permutation of s
+		}
+	}
+	s := []int{5, 4, 3, 2, 1}
+	PartialSort(s, 9)
+	expect(t, s, []int{1, 2, 3, 4, 5})
+	PartialSortFunc(s, 2, func(a, b int) bool { return a > b })
+	expect(t, s[:2], []int{5, 4})
+}
+
+func TestPartialSortWorstCase(t *testing.T) {
+	const n, k = 4096, 64
+	s, comp, count := adversary(n)
+	PartialSortFunc(s, k, comp)
+	if *count > 3*n*int(math.Log2(k)) {
+		t.Fatalf("%s failed: %d comparisons for %d elements", t.Name(), *count, n)
+	}
+}
+
+func TestPartialSortCopy(t *testing.T) {
+	s := []int{9, 3, 7, 1, 8, 2}
+	expect(t, PartialSortCopy(s, 3), []int{1, 2, 3})
+	expect(t, s, []int{9, 3, 7, 1, 8, 2})
+	expect(t, PartialSortCopy(s, 0), []int{})
+	expect(t, PartialSortCopy(s, 10), []int{1, 2, 3, 7, 8, 9})
+	desc := func(a, b int) bool { return a > b }
+	expect(t, PartialSortCopyFunc(s, 2, desc), []int{9, 8})
+}
+
+func TestTopK(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	s := make([]int, 10000)
+	for i := range s {
+		s[i] = r.Intn(1000000)
+	}
+	sorted := slices.Sorted(slices.Values(s))
+	expect(t, TopK(slices.Values(s), 10), sorted[:10])
+	expect(t, TopK(slices.Values(s[:3]), 10), slices.Sorted(slices.Values(s[:3])))
+	expect(t, TopK(slices.Values(s), 0), []int{})
+	expect(t, TopK(slices.Values(s), -1), []int{})
+
+	desc := func(a, b int) bool { return a > b }
+	top := TopKFunc(slices.Values(s), 3, desc)
+	expect(t, top, []int{sorted[9999], sorted[9998], sorted[9997]})
+}
+
+func TestTopKStreaming(t *testing.T) {
+	pulled := 0
+	seq := func(yield func(int) bool) {
+		for i := 100000; i > 0; i-- {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	expect(t, TopK(seq, 3), []int{1, 2, 3})
+	expect(t, pulled, 100000)
+}
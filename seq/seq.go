@@ -0,0 +1,182 @@
+// Package seq provides lazy, composable algorithms over iter.Seq and iter.Seq2.
+// Nothing is evaluated until the resulting sequence is ranged over, and no
+// intermediate slices are allocated between steps of a pipeline.
+package seq
+
+import "iter"
+
+// Return a sequence of the results of applying function f on every element of s
+func Map[T any, O any](s iter.Seq[T], f func(T) O) iter.Seq[O] {
+	return func(yield func(O) bool) {
+		for v := range s {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence of the results of applying function f on every key/value
+// pair of s
+func Map2[K, V, KO, VO any](s iter.Seq2[K, V], f func(K, V) (KO, VO)) iter.Seq2[KO, VO] {
+	return func(yield func(KO, VO) bool) {
+		for k, v := range s {
+			if !yield(f(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence containing only the elements of s for which f returns true
+func Filter[T any](s iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence containing only the key/value pairs of s for which f
+// returns true
+func Filter2[K, V any](s iter.Seq2[K, V], f func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range s {
+			if f(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence of at most the first n elements of s. s is not advanced
+// past the nth element.
+func Take[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i == n {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence that skips the first n elements of s
+func Drop[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range s {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence of the leading elements of s for which f returns true,
+// stopping at the first element for which f returns false
+func TakeWhile[T any](s iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if !f(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence that skips the leading elements of s for which f returns
+// true, then yields every remaining element
+func DropWhile[T any](s iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range s {
+			if dropping && f(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence of pairs taken from a and b in step. The sequence ends when
+// either a or b is exhausted.
+func Zip[A any, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := next()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence of every element of each of seqs in turn
+func Chain[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, s := range seqs {
+			for v := range s {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Return a sequence of the elements of s paired with their zero based position
+func Enumerate[T any](s iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range s {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Return a sequence of every element of each of the sequences produced by s
+func Flatten[T any](s iter.Seq[iter.Seq[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for inner := range s {
+			for v := range inner {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Apply an accumulator function f to every element of s and return the final
+// result. The accumulator is initialized with init, then with the result of
+// subsequent iterations over s.
+func Reduce[T any, O any](s iter.Seq[T], init O, f func(acc O, v T) O) O {
+	result := init
+	for v := range s {
+		result = f(result, v)
+	}
+	return result
+}
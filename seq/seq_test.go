@@ -0,0 +1,141 @@
+package seq
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+func expect[T any](t *testing.T, actual T, want T) {
+	t.Helper()
+	expected.Expect(t, actual, want)
+}
+
+// count the number of elements pulled from the returned sequence
+func counting[T any](s []T, n *int) func(func(T) bool) {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			*n++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	s := slices.Values([]int{1, 2, 3, 4})
+	add2 := slices.Collect(Map(s, func(v int) float64 { return float64(v) + 2.0 }))
+	expect(t, add2, []float64{3.0, 4.0, 5.0, 6.0})
+	mul2 := slices.Collect(Map(s, func(v int) int { return v * 2 }))
+	expect(t, mul2, []int{2, 4, 6, 8})
+}
+
+func TestMap2(t *testing.T) {
+	s := slices.All([]string{"a", "b"})
+	m := maps.Collect(Map2(s, func(i int, v string) (string, int) { return v, i }))
+	expect(t, m, map[string]int{"a": 0, "b": 1})
+}
+
+func TestFilter(t *testing.T) {
+	s := slices.Values([]int{1, 2, 3, 4})
+	f := slices.Collect(Filter(s, func(v int) bool { return v != 3 }))
+	expect(t, f, []int{1, 2, 4})
+}
+
+func TestFilter2(t *testing.T) {
+	s := slices.All([]int{5, 6, 7, 8})
+	f := maps.Collect(Filter2(s, func(i, v int) bool { return i%2 == 0 }))
+	expect(t, f, map[int]int{0: 5, 2: 7})
+}
+
+func TestTake(t *testing.T) {
+	n := 0
+	s := counting([]int{1, 2, 3, 4, 5}, &n)
+	expect(t, slices.Collect(Take(s, 2)), []int{1, 2})
+	expect(t, n, 2) // not advanced past the last element taken
+	expect(t, slices.Collect(Take(s, 0)), []int(nil))
+	expect(t, slices.Collect(Take(s, 9)), []int{1, 2, 3, 4, 5})
+}
+
+func TestDrop(t *testing.T) {
+	s := slices.Values([]int{1, 2, 3, 4, 5})
+	expect(t, slices.Collect(Drop(s, 2)), []int{3, 4, 5})
+	expect(t, slices.Collect(Drop(s, 0)), []int{1, 2, 3, 4, 5})
+	expect(t, slices.Collect(Drop(s, 9)), []int(nil))
+}
+
+func TestTakeWhile(t *testing.T) {
+	s := slices.Values([]int{1, 2, 3, 1, 2})
+	r := slices.Collect(TakeWhile(s, func(v int) bool { return v < 3 }))
+	expect(t, r, []int{1, 2})
+}
+
+func TestDropWhile(t *testing.T) {
+	s := slices.Values([]int{1, 2, 3, 1, 2})
+	r := slices.Collect(DropWhile(s, func(v int) bool { return v < 3 }))
+	expect(t, r, []int{3, 1, 2})
+}
+
+func TestZip(t *testing.T) {
+	a := slices.Values([]int{1, 2, 3})
+	b := slices.Values([]string{"a", "b"})
+	keys := []int{}
+	values := []string{}
+	for k, v := range Zip(a, b) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	expect(t, keys, []int{1, 2})
+	expect(t, values, []string{"a", "b"})
+
+	keys = keys[:0]
+	for k := range Zip(a, a) {
+		keys = append(keys, k)
+		break
+	}
+	expect(t, keys, []int{1})
+}
+
+func TestChain(t *testing.T) {
+	a := slices.Values([]int{1, 2})
+	b := slices.Values([]int{3})
+	expect(t, slices.Collect(Chain(a, b, a)), []int{1, 2, 3, 1, 2})
+	expect(t, slices.Collect(Chain[int]()), []int(nil))
+	expect(t, slices.Collect(Take(Chain(a, b), 3)), []int{1, 2, 3})
+}
+
+func TestEnumerate(t *testing.T) {
+	s := slices.Values([]string{"a", "b", "c"})
+	indexes := []int{}
+	for i := range Enumerate(s) {
+		indexes = append(indexes, i)
+	}
+	expect(t, indexes, []int{0, 1, 2})
+}
+
+func TestFlatten(t *testing.T) {
+	s := slices.Values([][]int{{1, 2}, {}, {3}})
+	f := Flatten(Map(s, slices.Values))
+	expect(t, slices.Collect(f), []int{1, 2, 3})
+	expect(t, slices.Collect(Take(f, 2)), []int{1, 2})
+}
+
+func TestReduce(t *testing.T) {
+	s := slices.Values([]int{1, 2, 3, 4})
+	sum := Reduce(s, 0, func(a int, v int) int { return a + v })
+	expect(t, sum, 10)
+	mul := Reduce(s, 1.0, func(a float64, v int) float64 { return a * float64(v) })
+	expect(t, mul, 24.0)
+}
+
+func TestPipeline(t *testing.T) {
+	n := 0
+	s := counting([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, &n)
+	evens := Filter(s, func(v int) bool { return v%2 == 0 })
+	squares := Map(evens, func(v int) int { return v * v })
+	expect(t, slices.Collect(Take(squares, 2)), []int{4, 16})
+	expect(t, n, 4)
+}
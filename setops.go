@@ -0,0 +1,137 @@
+package algo
+
+import "cmp"
+
+// The set operations work on sorted slices in linear time. Duplicate elements
+// follow the multiset rules of the C++ standard library: when a value appears
+// m times in a and n times in b, the union holds it max(m, n) times, the
+// intersection min(m, n) times, the difference max(m-n, 0) times and the
+// symmetric difference |m-n| times.
+
+// Create and return a sorted slice of the elements that are in either of the
+// sorted slices a or b or both. Elements are ordered using <
+func SetUnion[T cmp.Ordered](a, b []T) []T {
+	return SetUnionFunc(a, b, cmp.Less[T])
+}
+
+// Create and return a sorted slice of the elements that are in either of the
+// sorted slices a or b or both. Equivalent elements are taken from a first.
+// Elements are ordered using the function comp.
+func SetUnionFunc[T any](a, b []T, comp func(x, y T) bool) []T {
+	r := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(b[j], a[i]) {
+			r = append(r, b[j])
+			j++
+		} else {
+			if !comp(a[i], b[j]) {
+				j++
+			}
+			r = append(r, a[i])
+			i++
+		}
+	}
+	r = append(r, a[i:]...)
+	return append(r, b[j:]...)
+}
+
+// Create and return a sorted slice of the elements that are in both of the
+// sorted slices a and b. Elements are ordered using <
+func SetIntersection[T cmp.Ordered](a, b []T) []T {
+	return SetIntersectionFunc(a, b, cmp.Less[T])
+}
+
+// Create and return a sorted slice of the elements that are in both of the
+// sorted slices a and b, taken from a. Elements are ordered using the function
+// comp.
+func SetIntersectionFunc[T any](a, b []T, comp func(x, y T) bool) []T {
+	r := make([]T, 0, min(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			i++
+		} else if comp(b[j], a[i]) {
+			j++
+		} else {
+			r = append(r, a[i])
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+// Create and return a sorted slice of the elements of the sorted slice a that
+// are not in the sorted slice b. Elements are ordered using <
+func SetDifference[T cmp.Ordered](a, b []T) []T {
+	return SetDifferenceFunc(a, b, cmp.Less[T])
+}
+
+// Create and return a sorted slice of the elements of the sorted slice a that
+// are not in the sorted slice b. Elements are ordered using the function comp.
+func SetDifferenceFunc[T any](a, b []T, comp func(x, y T) bool) []T {
+	r := make([]T, 0, len(a))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			r = append(r, a[i])
+			i++
+		} else if comp(b[j], a[i]) {
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+	return append(r, a[i:]...)
+}
+
+// Create and return a sorted slice of the elements that are in one of the
+// sorted slices a or b, but not both. Elements are ordered using <
+func SetSymmetricDifference[T cmp.Ordered](a, b []T) []T {
+	return SetSymmetricDifferenceFunc(a, b, cmp.Less[T])
+}
+
+// Create and return a sorted slice of the elements that are in one of the
+// sorted slices a or b, but not both. Elements are ordered using the function
+// comp.
+func SetSymmetricDifferenceFunc[T any](a, b []T, comp func(x, y T) bool) []T {
+	r := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comp(a[i], b[j]) {
+			r = append(r, a[i])
+			i++
+		} else if comp(b[j], a[i]) {
+			r = append(r, b[j])
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+	r = append(r, a[i:]...)
+	return append(r, b[j:]...)
+}
+
+// Return true if every element of the sorted slice b is also in the sorted
+// slice a, counting duplicates. Elements are ordered using <
+func Includes[T cmp.Ordered](a, b []T) bool {
+	return IncludesFunc(a, b, cmp.Less[T])
+}
+
+// Return true if every element of the sorted slice b is also in the sorted
+// slice a, counting duplicates. Elements are ordered using the function comp.
+func IncludesFunc[T any](a, b []T, comp func(x, y T) bool) bool {
+	i := 0
+	for j := 0; j < len(b); i++ {
+		if i == len(a) || comp(b[j], a[i]) {
+			return false
+		}
+		if !comp(a[i], b[j]) {
+			j++
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package algo
+
+import "testing"
+
+func TestSetUnion(t *testing.T) {
+	data := []struct {
+		a    []int
+		b    []int
+		want []int
+	}{
+		{[]int{}, []int{}, []int{}},
+		{[]int{1, 2}, []int{}, []int{1, 2}},
+		{[]int{}, []int{1, 2}, []int{1, 2}},
+		{[]int{1, 3, 5}, []int{2, 3, 4}, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 2, 2, 2}, []int{2, 2, 3}, []int{1, 2, 2, 2, 3}},
+		{[]int{2}, []int{2, 2, 2}, []int{2, 2, 2}},
+	}
+	for _, v := range data {
+		expect(t, SetUnion(v.a, v.b), v.want)
+	}
+}
+
+func TestSetIntersection(t *testing.T) {
+	data := []struct {
+		a    []int
+		b    []int
+		want []int
+	}{
+		{[]int{}, []int{1}, []int{}},
+		{[]int{1, 3, 5, 7}, []int{2, 3, 4, 7}, []int{3, 7}},
+		{[]int{1, 2, 2, 2}, []int{2, 2, 3}, []int{2, 2}},
+		{[]int{1, 2}, []int{3, 4}, []int{}},
+	}
+	for _, v := range data {
+		expect(t, SetIntersection(v.a, v.b), v.want)
+	}
+}
+
+func TestSetDifference(t *testing.T) {
+	data := []struct {
+		a    []int
+		b    []int
+		want []int
+	}{
+		{[]int{}, []int{1}, []int{}},
+		{[]int{1, 2}, []int{}, []int{1, 2}},
+		{[]int{1, 3, 5, 7}, []int{2, 3, 4, 7}, []int{1, 5}},
+		{[]int{1, 2, 2, 2}, []int{2, 3}, []int{1, 2, 2}},
+		{[]int{2}, []int{2, 2}, []int{}},
+	}
+	for _, v := range data {
+		expect(t, SetDifference(v.a, v.b), v.want)
+	}
+}
+
+func TestSetSymmetricDifference(t *testing.T) {
+	data := []struct {
+		a    []int
+		b    []int
+		want []int
+	}{
+		{[]int{}, []int{}, []int{}},
+		{[]int{1, 3, 5, 7}, []int{2, 3, 4, 7, 9}, []int{1, 2, 4, 5, 9}},
+		{[]int{1, 2, 2, 2}, []int{2, 3}, []int{1, 2, 2, 3}},
+		{[]int{2}, []int{2, 2, 2}, []int{2, 2}},
+	}
+	for _, v := range data {
+		expect(t, SetSymmetricDifference(v.a, v.b), v.want)
+	}
+}
+
+func TestIncludes(t *testing.T) {
+	a := []int{1, 2, 2, 3, 5}
+	expect(t, Includes(a, []int{}), true)
+	expect(t, Includes(a, []int{2, 2, 5}), true)
+	expect(t, Includes(a, []int{2, 2, 2}), false)
+	expect(t, Includes(a, []int{4}), false)
+	expect(t, Includes(a, []int{6}), false)
+	expect(t, Includes([]int{}, []int{1}), false)
+	expect(t, Includes([]int{}, []int{}), true)
+}
+
+func TestSetFunc(t *testing.T) {
+	type item struct {
+		k   int
+		src string
+	}
+	comp := func(x, y item) bool { return x.k < y.k }
+	a := []item{{1, "a"}, {2, "a"}, {4, "a"}}
+	b := []item{{2, "b"}, {3, "b"}, {4, "b"}, {4, "b"}}
+	expect(t, SetUnionFunc(a, b, comp),
+		[]item{{1, "a"}, {2, "a"}, {3, "b"}, {4, "a"}, {4, "b"}})
+	expect(t, SetIntersectionFunc(a, b, comp), []item{{2, "a"}, {4, "a"}})
+	expect(t, SetDifferenceFunc(a, b, comp), []item{{1, "a"}})
+	expect(t, SetSymmetricDifferenceFunc(a, b, comp),
+		[]item{{1, "a"}, {3, "b"}, {4, "b"}})
+	expect(t, IncludesFunc(b, []item{{4, "x"}, {4, "y"}}, comp), true)
+
+	desc := func(x, y int) bool { return x > y }
+	expect(t, SetUnionFunc([]int{5, 3, 1}, []int{4, 3}, desc), []int{5, 4, 3, 1})
+}
+
+func TestSetOfMerge(t *testing.T) {
+	a := Merge([]int{1, 4}, []int{2, 4})
+	b := Merge([]int{4}, []int{2, 9})
+	expect(t, SetIntersection(a, b), []int{2, 4})
+	expect(t, Includes(SetUnion(a, b), a), true)
+}
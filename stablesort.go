@@ -0,0 +1,147 @@
+package algo
+
+import "cmp"
+
+// Merge the two adjacent sorted runs s[:middle] and s[middle:] so that all of
+// s is sorted. The merge is stable: equal elements keep their relative order
+// with those of s[:middle] first. Allocates a buffer of min(middle,
+// len(s)-middle) elements and makes O(len(s)) comparisons. Does nothing if
+// middle is out of range. Elements are ordered using <
+func InplaceMerge[T cmp.Ordered](s []T, middle int) {
+	InplaceMergeFunc(s, middle, cmp.Less[T])
+}
+
+// Merge the two adjacent sorted runs s[:middle] and s[middle:] so that all of
+// s is sorted. The merge is stable. Allocates a buffer of min(middle,
+// len(s)-middle) elements and makes O(len(s)) comparisons. Does nothing if
+// middle is out of range. Elements are ordered using the function comp.
+func InplaceMergeFunc[T any](s []T, middle int, comp func(a, b T) bool) {
+	if middle <= 0 || middle >= len(s) {
+		return
+	}
+	buf := make([]T, min(middle, len(s)-middle))
+	mergeAdaptive(s, middle, buf, comp)
+}
+
+// Same as InplaceMerge but uses buf as scratch space instead of allocating. If
+// buf is shorter than min(middle, len(s)-middle), including nil, the merge
+// falls back to splitting the runs with binary searches and rotations, which
+// takes O(n log n) comparisons and swaps for n = len(s) without allocating.
+// Elements are ordered using <
+func InplaceMergeBuffer[T cmp.Ordered](s []T, middle int, buf []T) {
+	InplaceMergeBufferFunc(s, middle, buf, cmp.Less[T])
+}
+
+// Same as InplaceMergeFunc but uses buf as scratch space instead of
+// allocating. If buf is shorter than min(middle, len(s)-middle), including nil,
+// the merge falls back to splitting the runs with binary searches and
+// rotations, which takes O(n log n) comparisons and swaps for n = len(s)
+// without allocating. Elements are ordered using the function comp.
+func InplaceMergeBufferFunc[T any](s []T, middle int, buf []T, comp func(a, b T) bool) {
+	if middle <= 0 || middle >= len(s) {
+		return
+	}
+	mergeAdaptive(s, middle, buf, comp)
+}
+
+// Sort s in place keeping equal elements in their original order. A bottom up
+// merge sort over insertion sorted blocks, allocating a buffer of len(s)/2
+// elements and making O(n log n) comparisons. Elements are ordered using <
+func StableSort[T cmp.Ordered](s []T) {
+	StableSortFunc(s, cmp.Less[T])
+}
+
+// Sort s in place keeping equal elements in their original order. A bottom up
+// merge sort over insertion sorted blocks, allocating a buffer of len(s)/2
+// elements and making O(n log n) comparisons. Elements are ordered using the
+// function comp.
+func StableSortFunc[T any](s []T, comp func(a, b T) bool) {
+	const block = 20
+	n := len(s)
+	for i := 0; i < n; i += block {
+		insertionSort(s[i:min(i+block, n)], comp)
+	}
+	if n <= block {
+		return
+	}
+	buf := make([]T, n/2)
+	for width := block; width < n; width *= 2 {
+		for i := 0; i+width < n; i += 2 * width {
+			mergeAdaptive(s[i:min(i+2*width, n)], width, buf, comp)
+		}
+	}
+}
+
+// Merge s[:middle] and s[middle:] using buf when the shorter run fits in it,
+// otherwise split both runs around a common value, rotate the middle pieces
+// into place and merge each half recursively
+func mergeAdaptive[T any](s []T, middle int, buf []T, comp func(a, b T) bool) {
+	left, right := middle, len(s)-middle
+	if left == 0 || right == 0 {
+		return
+	}
+	if !comp(s[middle], s[middle-1]) {
+		return // already in order
+	}
+	if left <= right && left <= len(buf) {
+		mergeLow(s, middle, buf, comp)
+		return
+	}
+	if right < left && right <= len(buf) {
+		mergeHigh(s, middle, buf, comp)
+		return
+	}
+	if len(s) == 2 {
+		s[0], s[1] = s[1], s[0]
+		return
+	}
+
+	var cut1, cut2 int
+	if left > right {
+		cut1 = left / 2
+		cut2 = middle + LowerBoundFunc(s[middle:], s[cut1], comp)
+	} else {
+		cut2 = middle + right/2
+		cut1 = UpperBoundFunc(s[:middle], s[cut2], comp)
+	}
+	Rotate(s[cut1:cut2], middle-cut1)
+	mid := cut1 + (cut2 - middle)
+	mergeAdaptive(s[:mid], cut1, buf, comp)
+	mergeAdaptive(s[mid:], cut2-mid, buf, comp)
+}
+
+// Merge by moving the left run into buf and merging forward
+func mergeLow[T any](s []T, middle int, buf []T, comp func(a, b T) bool) {
+	a := buf[:copy(buf, s[:middle])]
+	i, j, k := 0, middle, 0
+	for i < len(a) && j < len(s) {
+		if comp(s[j], a[i]) {
+			s[k] = s[j]
+			j++
+		} else {
+			s[k] = a[i]
+			i++
+		}
+		k++
+	}
+	copy(s[k:], a[i:])
+	clear(a)
+}
+
+// Merge by moving the right run into buf and merging backward
+func mergeHigh[T any](s []T, middle int, buf []T, comp func(a, b T) bool) {
+	b := buf[:copy(buf, s[middle:])]
+	i, j, k := middle-1, len(b)-1, len(s)-1
+	for i >= 0 && j >= 0 {
+		if comp(b[j], s[i]) {
+			s[k] = s[i]
+			i--
+		} else {
+			s[k] = b[j]
+			j--
+		}
+		k--
+	}
+	copy(s[:j+1], b[:j+1])
+	clear(b)
+}
@@ -0,0 +1,144 @@
+package algo
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type stableItem struct {
+	key   int
+	order int
+}
+
+func lessKey(a, b stableItem) bool {
+	return a.key < b.key
+}
+
+func makeStableItems(r *rand.Rand, n, keys int) []stableItem {
+	s := make([]stableItem, n)
+	for i := range s {
+		s[i] = stableItem{r.Intn(keys), i}
+	}
+	return s
+}
+
+func expectStable(t *testing.T, s []stableItem) {
+	t.Helper()
+	for i := 1; i < len(s); i++ {
+		a, b := s[i-1], s[i]
+		if b.key < a.key || b.key == a.key && b.order < a.order {
+			t.Fatalf("%s failed: unstable at %d: %v %v", t.Name(), i, a, b)
+		}
+	}
+}
+
+func TestInplaceMerge(t *testing.T) {
+	data := []struct {
+		s      []int
+		middle int
+		want   []int
+	}{
+		{[]int{}, 0, []int{}},
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{[]int{3, 1, 2}, 3, []int{3, 1, 2}},
+		{[]int{1, 5, 7, 2, 3, 9}, 3, []int{1, 2, 3, 5, 7, 9}},
+		{[]int{4, 5, 6, 1, 2, 3}, 3, []int{1, 2, 3, 4, 5, 6}},
+		{[]int{2, 2, 1, 2}, 2, []int{1, 2, 2, 2}},
+		{[]int{9, 1, 2, 3, 4}, 1, []int{1, 2, 3, 4, 9}},
+	}
+	for _, v := range data {
+		InplaceMerge(v.s, v.middle)
+		expect(t, v.s, v.want)
+	}
+}
+
+func TestInplaceMergeStable(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, bufSize := range []int{-1, 0, 3, 1000} {
+		for _, middle := range []int{1, 17, 250, 499} {
+			s := makeStableItems(r, 500, 20)
+			slices.SortStableFunc(s[:middle], func(a, b stableItem) int { return cmp.Compare(a.key, b.key) })
+			slices.SortStableFunc(s[middle:], func(a, b stableItem) int { return cmp.Compare(a.key, b.key) })
+			switch bufSize {
+			case -1:
+				InplaceMergeFunc(s, middle, lessKey)
+			case 0:
+				InplaceMergeBufferFunc(s, middle, nil, lessKey)
+			default:
+				InplaceMergeBufferFunc(s, middle, make([]stableItem, bufSize), lessKey)
+			}
+			expectStable(t, s)
+		}
+	}
+	s := []int{1, 4, 2, 3}
+	InplaceMergeBuffer(s, 2, nil)
+	expect(t, s, []int{1, 2, 3, 4})
+}
+
+func TestStableSort(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for name, s := range testInputs(r, 1000) {
+		want := slices.Clone(s)
+		slices.Sort(want)
+		StableSort(s)
+		if !slices.Equal(s, want) {
+			t.Fatalf("%s failed: %s not sorted", t.Name(), name)
+		}
+	}
+	for _, n := range []int{0, 1, 19, 20, 21, 41, 1000, 1023} {
+		s := makeStableItems(r, n, 7)
+		StableSortFunc(s, lessKey)
+		expectStable(t, s)
+	}
+}
+
+func benchmarkInput(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Intn(n / 4)
+	}
+	return s
+}
+
+func BenchmarkStableSortFunc(b *testing.B) {
+	src := benchmarkInput(100000)
+	s := make([]int, len(src))
+	for i := 0; i < b.N; i++ {
+		copy(s, src)
+		StableSortFunc(s, func(a, b int) bool { return a < b })
+	}
+}
+
+func BenchmarkSlicesSortStableFunc(b *testing.B) {
+	src := benchmarkInput(100000)
+	s := make([]int, len(src))
+	for i := 0; i < b.N; i++ {
+		copy(s, src)
+		slices.SortStableFunc(s, func(a, b int) int { return cmp.Compare(a, b) })
+	}
+}
+
+func BenchmarkInplaceMerge(b *testing.B) {
+	src := benchmarkInput(100000)
+	slices.Sort(src[:50000])
+	slices.Sort(src[50000:])
+	s := make([]int, len(src))
+	for i := 0; i < b.N; i++ {
+		copy(s, src)
+		InplaceMerge(s, 50000)
+	}
+}
+
+func BenchmarkInplaceMergeNoBuffer(b *testing.B) {
+	src := benchmarkInput(100000)
+	slices.Sort(src[:50000])
+	slices.Sort(src[50000:])
+	s := make([]int, len(src))
+	for i := 0; i < b.N; i++ {
+		copy(s, src)
+		InplaceMergeBuffer(s, 50000, nil)
+	}
+}
@@ -6,6 +6,8 @@
 
 package vessels
 
+import "iter"
+
 const INITIAL_DEQUE_SIZE = 32
 
 /** Deque */
@@ -217,3 +219,56 @@ func (d *Deque[T]) Clone() *Deque[T] {
 	clone.head = d.copy(clone.buf)
 	return clone
 }
+
+/**
+ * create a new Deque[T] containing the values of seq in order
+ */
+func CollectDeque[T any](seq iter.Seq[T]) *Deque[T] {
+	d := NewDeque[T]()
+	for v := range seq {
+		d.PushBack(v)
+	}
+	return d
+}
+
+/**
+ * return an iterator over the index/value pairs of the Deque from front to back.
+ * The Deque must not be modified during iteration.
+ */
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.Len(); i++ {
+			if !yield(i, d.buf[(d.tail+i)%len(d.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * return an iterator over the index/value pairs of the Deque from back to
+ * front. The Deque must not be modified during iteration.
+ */
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := d.Len() - 1; i >= 0; i-- {
+			if !yield(i, d.buf[(d.tail+i)%len(d.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * return an iterator over the values of the Deque from front to back.
+ * The Deque must not be modified during iteration.
+ */
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range d.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
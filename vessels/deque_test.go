@@ -1,6 +1,7 @@
 package vessels
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/clayessex/algo/expected"
@@ -400,3 +401,59 @@ func TestShrink(t *testing.T) {
 	x.ExpectOk(d.PopBack()).ToBe(8)
 	x.ExpectOk(d.PopBack()).ToBe(9)
 }
+
+func TestDequeAll(t *testing.T) {
+	d := NewDeque[int](4)
+	d.PushBack(8)
+	d.PushBack(7)
+	d.PushFront(9) // wraps the ring buffer
+	indexes := []int{}
+	values := []int{}
+	for i, v := range d.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	expect(t, indexes, []int{0, 1, 2})
+	expect(t, values, []int{9, 8, 7})
+
+	values = values[:0]
+	for _, v := range d.All() {
+		if v == 8 {
+			break
+		}
+		values = append(values, v)
+	}
+	expect(t, values, []int{9})
+}
+
+func TestDequeBackward(t *testing.T) {
+	d := NewDeque[int](4)
+	d.PushBack(8)
+	d.PushBack(7)
+	d.PushFront(9)
+	indexes := []int{}
+	values := []int{}
+	for i, v := range d.Backward() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	expect(t, indexes, []int{2, 1, 0})
+	expect(t, values, []int{7, 8, 9})
+}
+
+func TestDequeValues(t *testing.T) {
+	d := NewDeque[int](2)
+	d.PushBack(9)
+	d.PushBack(8)
+	d.PushBack(7)
+	expect(t, slices.Collect(d.Values()), []int{9, 8, 7})
+	expect(t, slices.Collect(NewDeque[int]().Values()), []int(nil))
+}
+
+func TestCollectDeque(t *testing.T) {
+	x := expected.New(t)
+	d := CollectDeque(slices.Values([]int{9, 8, 7}))
+	x.Expect(d.Len()).ToBe(3)
+	x.ExpectOk(d.PopFront()).ToBe(9)
+	x.ExpectOk(d.PopBack()).ToBe(7)
+}
@@ -0,0 +1,310 @@
+package vessels
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"iter"
+	"slices"
+)
+
+// Marshalling for the containers. JSON uses an array of the values in
+// iteration order. Gob and binary encoding both use encoding/gob on the same
+// slice of values, so T must itself be gob encodable.
+
+// collect the values of seq into a non-nil slice
+func collectValues[T any](seq iter.Seq[T], n int) []T {
+	return slices.AppendSeq(make([]T, 0, n), seq)
+}
+
+// values of an index/value sequence
+func seqValues[T any](seq iter.Seq2[int, T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// decode a JSON array, returning false for a JSON null
+func unmarshalJSONValues[T any](data []byte) ([]T, bool, error) {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, false, err
+	}
+	return values, values != nil, nil
+}
+
+func gobEncodeValues[T any](values []T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecodeValues[T any](data []byte) ([]T, error) {
+	var values []T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values)
+	return values, err
+}
+
+// replace the contents of d with values
+func (d *Deque[T]) assign(values []T) {
+	*d = *NewDeque[T](max(len(values), INITIAL_DEQUE_SIZE))
+	for _, v := range values {
+		d.PushBack(v)
+	}
+}
+
+/** encode the Deque as a JSON array from front to back */
+func (d *Deque[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(collectValues(d.Values(), d.Len()))
+}
+
+/**
+ * decode a JSON array into the Deque from front to back, replacing its
+ * contents. A JSON null leaves the Deque unchanged
+ */
+func (d *Deque[T]) UnmarshalJSON(data []byte) error {
+	values, ok, err := unmarshalJSONValues[T](data)
+	if ok {
+		d.assign(values)
+	}
+	return err
+}
+
+/** encode the Deque values from front to back using encoding/gob */
+func (d *Deque[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(collectValues(d.Values(), d.Len()))
+}
+
+/** decode values written by GobEncode, replacing the contents of the Deque */
+func (d *Deque[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		d.assign(values)
+	}
+	return err
+}
+
+/** same as GobEncode */
+func (d *Deque[T]) MarshalBinary() ([]byte, error) {
+	return d.GobEncode()
+}
+
+/** same as GobDecode */
+func (d *Deque[T]) UnmarshalBinary(data []byte) error {
+	return d.GobDecode(data)
+}
+
+// Encode the Queue as a JSON array from front to back (pop order)
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return (*Deque[T])(q).MarshalJSON()
+}
+
+// Decode a JSON array into the Queue from front to back, replacing its contents
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	return (*Deque[T])(q).UnmarshalJSON(data)
+}
+
+func (q *Queue[T]) GobEncode() ([]byte, error) {
+	return (*Deque[T])(q).GobEncode()
+}
+
+func (q *Queue[T]) GobDecode(data []byte) error {
+	return (*Deque[T])(q).GobDecode(data)
+}
+
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return (*Deque[T])(q).MarshalBinary()
+}
+
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	return (*Deque[T])(q).UnmarshalBinary(data)
+}
+
+// Encode the Stack as a JSON array from bottom to top (the last element is the
+// next to be popped)
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return (*Deque[T])(s).MarshalJSON()
+}
+
+// Decode a JSON array into the Stack from bottom to top, replacing its contents
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	return (*Deque[T])(s).UnmarshalJSON(data)
+}
+
+func (s *Stack[T]) GobEncode() ([]byte, error) {
+	return (*Deque[T])(s).GobEncode()
+}
+
+func (s *Stack[T]) GobDecode(data []byte) error {
+	return (*Deque[T])(s).GobDecode(data)
+}
+
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return (*Deque[T])(s).MarshalBinary()
+}
+
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	return (*Deque[T])(s).UnmarshalBinary(data)
+}
+
+// values of the list from front to back, the zero List is empty
+func (list *List[T]) encodeValues() []T {
+	if list.head == nil {
+		return []T{}
+	}
+	return collectValues(seqValues(list.All()), list.Len())
+}
+
+// replace the contents of the list with values
+func (list *List[T]) assign(values []T) {
+	if list.head == nil {
+		*list = *NewList[T]()
+	} else {
+		list.Clear()
+	}
+	list.Append(values...)
+}
+
+// Encode the list as a JSON array from front to back
+func (list *List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.encodeValues())
+}
+
+// Decode a JSON array into the list from front to back, replacing its
+// contents. A JSON null leaves the list unchanged
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	values, ok, err := unmarshalJSONValues[T](data)
+	if ok {
+		list.assign(values)
+	}
+	return err
+}
+
+// Encode the list values from front to back using encoding/gob
+func (list *List[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(list.encodeValues())
+}
+
+// Decode values written by GobEncode, replacing the contents of the list
+func (list *List[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeValues[T](data)
+	if err == nil {
+		list.assign(values)
+	}
+	return err
+}
+
+// Same as GobEncode
+func (list *List[T]) MarshalBinary() ([]byte, error) {
+	return list.GobEncode()
+}
+
+// Same as GobDecode
+func (list *List[T]) UnmarshalBinary(data []byte) error {
+	return list.GobDecode(data)
+}
+
+// replace the contents of the Set with elements
+func (s *Set[T]) assign(elements []T) {
+	if *s == nil {
+		*s = NewSet(elements...)
+		return
+	}
+	s.Clear()
+	s.Append(elements...)
+}
+
+// Encode the Set as a JSON array. The order of the elements is unspecified
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Keys())
+}
+
+// Decode a JSON array into the Set, replacing its contents. Duplicate elements
+// are merged. A JSON null leaves the Set unchanged
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	elements, ok, err := unmarshalJSONValues[T](data)
+	if ok {
+		s.assign(elements)
+	}
+	return err
+}
+
+// Encode the Set elements using encoding/gob
+func (s Set[T]) GobEncode() ([]byte, error) {
+	return gobEncodeValues(s.Keys())
+}
+
+// Decode elements written by GobEncode, replacing the contents of the Set
+func (s *Set[T]) GobDecode(data []byte) error {
+	elements, err := gobDecodeValues[T](data)
+	if err == nil {
+		s.assign(elements)
+	}
+	return err
+}
+
+// Same as GobEncode
+func (s Set[T]) MarshalBinary() ([]byte, error) {
+	return s.GobEncode()
+}
+
+// Same as GobDecode
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	return s.GobDecode(data)
+}
+
+// gob representation of an OrderedMap
+type orderedMapGob[K comparable, V any] struct {
+	Keys   []K
+	Values []V
+}
+
+// Encode the keys and values in insertion order using encoding/gob
+func (m *OrderedMap[K, V]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	r := orderedMapGob[K, V]{}
+	if m.data != nil {
+		r.Keys, r.Values = m.Keys(), m.Values()
+	}
+	if err := gob.NewEncoder(&buf).Encode(r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode keys and values written by GobEncode, replacing the contents of the map
+func (m *OrderedMap[K, V]) GobDecode(data []byte) error {
+	var r orderedMapGob[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&r); err != nil {
+		return err
+	}
+	if len(r.Keys) != len(r.Values) {
+		return errors.New("vessels: OrderedMap gob has mismatched keys and values")
+	}
+	if m.data == nil {
+		*m = *NewOrderedMap[K, V](len(r.Keys))
+	} else {
+		m.Clear()
+	}
+	for i, key := range r.Keys {
+		m.Insert(key, r.Values[i])
+	}
+	return nil
+}
+
+// Same as GobEncode
+func (m *OrderedMap[K, V]) MarshalBinary() ([]byte, error) {
+	return m.GobEncode()
+}
+
+// Same as GobDecode
+func (m *OrderedMap[K, V]) UnmarshalBinary(data []byte) error {
+	return m.GobDecode(data)
+}
@@ -0,0 +1,197 @@
+package vessels
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+type binaryCodec interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// round trip src through json into dst and return the encoded JSON
+func jsonRoundTrip(t *testing.T, src any, dst any) string {
+	t.Helper()
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("%s failed: marshal: %v", t.Name(), err)
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		t.Fatalf("%s failed: unmarshal: %v", t.Name(), err)
+	}
+	return string(data)
+}
+
+// round trip src through gob into dst
+func gobRoundTrip(t *testing.T, src any, dst any) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(src); err != nil {
+		t.Fatalf("%s failed: encode: %v", t.Name(), err)
+	}
+	if err := gob.NewDecoder(&buf).Decode(dst); err != nil {
+		t.Fatalf("%s failed: decode: %v", t.Name(), err)
+	}
+}
+
+// round trip src through MarshalBinary into dst
+func binaryRoundTrip(t *testing.T, src binaryCodec, dst binaryCodec) {
+	t.Helper()
+	data, err := src.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%s failed: marshal: %v", t.Name(), err)
+	}
+	if err := dst.UnmarshalBinary(data); err != nil {
+		t.Fatalf("%s failed: unmarshal: %v", t.Name(), err)
+	}
+}
+
+func TestDequeEncoding(t *testing.T) {
+	d := NewDeque[int](2)
+	d.PushBack(8)
+	d.PushBack(7)
+	d.PushFront(9)
+
+	var j Deque[int]
+	expect(t, jsonRoundTrip(t, d, &j), `[9,8,7]`)
+	expect(t, slices.Collect(j.Values()), []int{9, 8, 7})
+	j.PushBack(6)
+	expect(t, j.Len(), 4)
+
+	g := NewDeque[int]()
+	g.PushBack(42)
+	gobRoundTrip(t, d, g)
+	expect(t, slices.Collect(g.Values()), []int{9, 8, 7})
+
+	b := NewDeque[int]()
+	binaryRoundTrip(t, d, b)
+	expect(t, slices.Collect(b.Values()), []int{9, 8, 7})
+
+	e := NewDeque[string]()
+	expect(t, jsonRoundTrip(t, NewDeque[string](), e), `[]`)
+	gobRoundTrip(t, NewDeque[string](), e)
+	expect(t, e.Len(), 0)
+}
+
+func TestQueueEncoding(t *testing.T) {
+	q := CollectQueue(slices.Values([]string{"a", "b", "c"}))
+	var j Queue[string]
+	expect(t, jsonRoundTrip(t, q, &j), `["a","b","c"]`)
+	x := expected.New(t)
+	x.ExpectOk(j.Pop()).ToBe("a")
+
+	var g Queue[string]
+	gobRoundTrip(t, q, &g)
+	x.ExpectOk(g.Pop()).ToBe("a")
+
+	var b Queue[string]
+	binaryRoundTrip(t, q, &b)
+	x.ExpectOk(b.Pop()).ToBe("a")
+}
+
+func TestStackEncoding(t *testing.T) {
+	s := CollectStack(slices.Values([]int{1, 2, 3}))
+	var j Stack[int]
+	expect(t, jsonRoundTrip(t, s, &j), `[1,2,3]`)
+	x := expected.New(t)
+	x.ExpectOk(j.Pop()).ToBe(3)
+
+	var g Stack[int]
+	gobRoundTrip(t, s, &g)
+	x.ExpectOk(g.Pop()).ToBe(3)
+
+	var b Stack[int]
+	binaryRoundTrip(t, s, &b)
+	x.ExpectOk(b.Pop()).ToBe(3)
+}
+
+func TestListEncoding(t *testing.T) {
+	list := CollectList(slices.Values([]float64{1.5, 2.5}))
+	var j List[float64]
+	expect(t, jsonRoundTrip(t, list, &j), `[1.5,2.5]`)
+	expect(t, j.Values(), []float64{1.5, 2.5})
+	j.PushBack(3.5)
+	expect(t, j.Len(), 3)
+
+	g := CollectList(slices.Values([]float64{9}))
+	gobRoundTrip(t, list, g)
+	expect(t, g.Values(), []float64{1.5, 2.5})
+
+	var b List[float64]
+	binaryRoundTrip(t, list, &b)
+	expect(t, b.Values(), []float64{1.5, 2.5})
+
+	var zero List[float64]
+	expect(t, jsonRoundTrip(t, &zero, &b), `[]`)
+	expect(t, b.Len(), 0)
+}
+
+func TestSetEncoding(t *testing.T) {
+	s := NewSet(3, 1, 2)
+	var j Set[int]
+	data := jsonRoundTrip(t, s, &j)
+	expect(t, len(data), len(`[1,2,3]`))
+	expect(t, j.Equal(s), true)
+
+	j = NewSet(9)
+	err := json.Unmarshal([]byte(`[4,4,5]`), &j)
+	expect(t, err, nil)
+	expect(t, j.Equal(NewSet(4, 5)), true)
+
+	var g Set[int]
+	gobRoundTrip(t, s, &g)
+	expect(t, g.Equal(s), true)
+
+	var b Set[int]
+	binaryRoundTrip(t, &s, &b)
+	expect(t, b.Equal(s), true)
+}
+
+func TestOMEncoding(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Insert("z", 1)
+	m.Insert("a", 2)
+
+	var g OrderedMap[string, int]
+	gobRoundTrip(t, m, &g)
+	expect(t, g.Keys(), []string{"z", "a"})
+	expect(t, g.Values(), []int{1, 2})
+
+	b := NewOrderedMap[string, int]()
+	b.Insert("q", 0)
+	binaryRoundTrip(t, m, b)
+	expect(t, b.Keys(), []string{"z", "a"})
+
+	var zero OrderedMap[string, int]
+	binaryRoundTrip(t, &zero, b)
+	expect(t, b.Len(), 0)
+}
+
+func TestEncodingNull(t *testing.T) {
+	d := CollectDeque(slices.Values([]int{1}))
+	list := CollectList(slices.Values([]int{1}))
+	s := NewSet(1)
+	for _, v := range []any{d, list, &s} {
+		if err := json.Unmarshal([]byte(`null`), v); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+	}
+	expect(t, d.Len(), 1)
+	expect(t, list.Len(), 1)
+	expect(t, s.Len(), 1)
+}
+
+func TestEncodingErrors(t *testing.T) {
+	x := expected.New(t)
+	x.Expect(json.Unmarshal([]byte(`{}`), NewDeque[int]()) != nil).ToBe(true)
+	x.Expect(json.Unmarshal([]byte(`["a"]`), NewList[int]()) != nil).ToBe(true)
+	x.Expect(NewDeque[int]().UnmarshalBinary([]byte("junk")) != nil).ToBe(true)
+	x.Expect(NewOrderedMap[int, int]().UnmarshalBinary([]byte("junk")) != nil).ToBe(true)
+}
@@ -0,0 +1,452 @@
+package vessels
+
+import "iter"
+
+// IndexedOrderedMap is a map that remembers the insertion order of elements
+// like OrderedMap, but keeps that order in a balanced tree indexed by position
+// instead of a List. Insert, Delete, At, IndexOf and InsertAt are all
+// O(log N), trading the O(1) Insert and Delete of OrderedMap for fast
+// positional access.
+type IndexedOrderedMap[K comparable, V any] struct {
+	nodes map[K]*orderNode[K, V] // map keys to tree nodes
+	root  *orderNode[K, V]       // tree of nodes in insertion order
+}
+
+// A node of the position tree. The in-order position of a node is its index,
+// which is found by walking up the parent pointers.
+type orderNode[K comparable, V any] struct {
+	left   *orderNode[K, V]
+	right  *orderNode[K, V]
+	parent *orderNode[K, V]
+	key    K
+	value  V
+	height int // height of the subtree rooted here, 1 for a leaf
+	size   int // number of nodes in the subtree rooted here
+}
+
+// Create a new IndexedOrderedMap with an optional initial allocation size
+func NewIndexedOrderedMap[K comparable, V any](size ...int) *IndexedOrderedMap[K, V] {
+	sz := INITIAL_DEQUE_SIZE
+	if len(size) > 0 {
+		sz = size[0]
+	}
+	return &IndexedOrderedMap[K, V]{make(map[K]*orderNode[K, V], sz), nil}
+}
+
+func (n *orderNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *orderNode[K, V]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// recalculate height and size from the children
+func (n *orderNode[K, V]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.size = 1 + n.left.getSize() + n.right.getSize()
+}
+
+// set the left child of n and its parent pointer
+func (n *orderNode[K, V]) setLeft(c *orderNode[K, V]) {
+	n.left = c
+	if c != nil {
+		c.parent = n
+	}
+}
+
+// set the right child of n and its parent pointer
+func (n *orderNode[K, V]) setRight(c *orderNode[K, V]) {
+	n.right = c
+	if c != nil {
+		c.parent = n
+	}
+}
+
+func (n *orderNode[K, V]) rotateLeft() *orderNode[K, V] {
+	r := n.right
+	r.parent = n.parent
+	n.setRight(r.left)
+	r.setLeft(n)
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *orderNode[K, V]) rotateRight() *orderNode[K, V] {
+	l := n.left
+	l.parent = n.parent
+	n.setLeft(l.right)
+	l.setRight(n)
+	n.update()
+	l.update()
+	return l
+}
+
+// restore the AVL balance of n after one of its subtrees changed height by at
+// most one and return the new subtree root
+func (n *orderNode[K, V]) rebalance() *orderNode[K, V] {
+	n.update()
+	switch bf := n.left.getHeight() - n.right.getHeight(); {
+	case bf > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.setLeft(n.left.rotateLeft())
+		}
+		return n.rotateRight()
+	case bf < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.setRight(n.right.rotateRight())
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// insert node x at position index of the subtree rooted at n and return the
+// new subtree root
+func (n *orderNode[K, V]) insertAt(index int, x *orderNode[K, V]) *orderNode[K, V] {
+	if n == nil {
+		x.left, x.right = nil, nil
+		x.update()
+		return x
+	}
+	if ls := n.left.getSize(); index <= ls {
+		n.setLeft(n.left.insertAt(index, x))
+	} else {
+		n.setRight(n.right.insertAt(index-ls-1, x))
+	}
+	return n.rebalance()
+}
+
+// remove the first node of the subtree, returns the new subtree root and the
+// removed node
+func (n *orderNode[K, V]) deleteFirst() (*orderNode[K, V], *orderNode[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	l, first := n.left.deleteFirst()
+	n.setLeft(l)
+	return n.rebalance(), first
+}
+
+// remove the node at position index of the subtree rooted at n and return the
+// new subtree root
+func (n *orderNode[K, V]) deleteAt(index int) *orderNode[K, V] {
+	ls := n.left.getSize()
+	if index < ls {
+		n.setLeft(n.left.deleteAt(index))
+	} else if index > ls {
+		n.setRight(n.right.deleteAt(index - ls - 1))
+	} else {
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		r, successor := n.right.deleteFirst()
+		successor.setLeft(n.left)
+		successor.setRight(r)
+		return successor.rebalance()
+	}
+	return n.rebalance()
+}
+
+// position of n in the tree
+func (n *orderNode[K, V]) index() int {
+	index := n.left.getSize()
+	for p := n; p.parent != nil; p = p.parent {
+		if p == p.parent.right {
+			index += p.parent.left.getSize() + 1
+		}
+	}
+	return index
+}
+
+// node following n in order or nil
+func (n *orderNode[K, V]) next() *orderNode[K, V] {
+	if n.right != nil {
+		n = n.right
+		for n.left != nil {
+			n = n.left
+		}
+		return n
+	}
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return n.parent
+}
+
+// node preceding n in order or nil
+func (n *orderNode[K, V]) prev() *orderNode[K, V] {
+	if n.left != nil {
+		n = n.left
+		for n.right != nil {
+			n = n.right
+		}
+		return n
+	}
+	for n.parent != nil && n == n.parent.left {
+		n = n.parent
+	}
+	return n.parent
+}
+
+// set the tree root
+func (m *IndexedOrderedMap[K, V]) setRoot(n *orderNode[K, V]) {
+	m.root = n
+	if n != nil {
+		n.parent = nil
+	}
+}
+
+// find the node at position index or nil
+func (m *IndexedOrderedMap[K, V]) nodeAt(index int) *orderNode[K, V] {
+	if index < 0 || index >= m.Len() {
+		return nil
+	}
+	n := m.root
+	for n != nil {
+		ls := n.left.getSize()
+		if index < ls {
+			n = n.left
+		} else if index > ls {
+			index -= ls + 1
+			n = n.right
+		} else {
+			break
+		}
+	}
+	return n
+}
+
+// insert a new key at position index
+func (m *IndexedOrderedMap[K, V]) insertAt(index int, key K, value V) {
+	n := &orderNode[K, V]{key: key, value: value}
+	m.nodes[key] = n
+	m.setRoot(m.root.insertAt(index, n))
+}
+
+// remove the node at position index
+func (m *IndexedOrderedMap[K, V]) deleteAt(index int) *orderNode[K, V] {
+	n := m.nodeAt(index)
+	delete(m.nodes, n.key)
+	m.setRoot(m.root.deleteAt(index))
+	n.left, n.right, n.parent = nil, nil, nil
+	return n
+}
+
+// Returns the current length of the map
+func (m *IndexedOrderedMap[K, V]) Len() int {
+	return len(m.nodes)
+}
+
+// Returns true if the IndexedOrderedMap contains the given key
+func (m *IndexedOrderedMap[K, V]) Contains(key K) bool {
+	_, ok := m.nodes[key]
+	return ok
+}
+
+// Returns the value for the given key if the key exists, otherwise a default
+// initialized value and false
+func (m *IndexedOrderedMap[K, V]) Value(key K) (V, bool) {
+	n, ok := m.nodes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Insert the key/value pair onto the end of the map (same as Push()). If the
+// key exists its value is overwritten in place.
+func (m *IndexedOrderedMap[K, V]) Insert(key K, value V) {
+	if n, ok := m.nodes[key]; ok {
+		n.value = value
+		return
+	}
+	m.insertAt(m.Len(), key, value)
+}
+
+// Insert the key/value pair so that it is at position index, shifting the
+// following keys back by one. index may be in the range [0, Len()]. Returns
+// false if the index is out of range or the key already exists.
+func (m *IndexedOrderedMap[K, V]) InsertAt(index int, key K, value V) bool {
+	if index < 0 || index > m.Len() || m.Contains(key) {
+		return false
+	}
+	m.insertAt(index, key, value)
+	return true
+}
+
+// Delete the key/value pair for the given key
+func (m *IndexedOrderedMap[K, V]) Delete(key K) bool {
+	n, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	m.deleteAt(n.index())
+	return true
+}
+
+// Delete the key/value pair at position index and return its key. If the index
+// is out of bounds then return a default initialized value and false.
+func (m *IndexedOrderedMap[K, V]) DeleteAt(index int) (K, bool) {
+	if index < 0 || index >= m.Len() {
+		var zero K
+		return zero, false
+	}
+	return m.deleteAt(index).key, true
+}
+
+// Push the key/value pair into the map (same as Insert())
+func (m *IndexedOrderedMap[K, V]) Push(key K, value V) {
+	m.Insert(key, value)
+}
+
+// Pop the last key/value pair from the end of the map unless the map is empty
+// and then it returns a default initialized value and false
+func (m *IndexedOrderedMap[K, V]) Pop() (K, bool) {
+	return m.DeleteAt(m.Len() - 1)
+}
+
+// Returns the key following the given key if there is one, otherwise returns a
+// default initialized value and false
+func (m *IndexedOrderedMap[K, V]) Next(key K) (K, bool) {
+	n, ok := m.nodes[key]
+	if !ok {
+		var zero K
+		return zero, false
+	}
+	return orderNodeKey(n.next())
+}
+
+// Returns the key preceding the given key if there is one, otherwise returns a
+// default initialized value and false
+func (m *IndexedOrderedMap[K, V]) Prev(key K) (K, bool) {
+	n, ok := m.nodes[key]
+	if !ok {
+		var zero K
+		return zero, false
+	}
+	return orderNodeKey(n.prev())
+}
+
+// key of n or a default initialized value and false if n is nil
+func orderNodeKey[K comparable, V any](n *orderNode[K, V]) (K, bool) {
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+	return n.key, true
+}
+
+// Returns the oldest key in insertion order unless the map is empty, then it
+// returns a default initialized value and false
+func (m *IndexedOrderedMap[K, V]) First() (K, bool) {
+	return m.KeyAt(0)
+}
+
+// Returns the newest key in insertion order unless the map is empty, then it
+// returns a default initialized value and false
+func (m *IndexedOrderedMap[K, V]) Last() (K, bool) {
+	return m.KeyAt(m.Len() - 1)
+}
+
+// Remove all key/value pairs from the map
+func (m *IndexedOrderedMap[K, V]) Clear() {
+	clear(m.nodes)
+	m.root = nil
+}
+
+// Return the value at position index in insertion order. If the index is out
+// of bounds then return a default initialized value and false.
+func (m *IndexedOrderedMap[K, V]) At(index int) (V, bool) {
+	n := m.nodeAt(index)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Return the key at position index in insertion order. If the index is out of
+// bounds then return a default initialized value and false.
+func (m *IndexedOrderedMap[K, V]) KeyAt(index int) (K, bool) {
+	return orderNodeKey(m.nodeAt(index))
+}
+
+// Return the position of key in insertion order if the key exists, otherwise
+// -1 and false
+func (m *IndexedOrderedMap[K, V]) IndexOf(key K) (int, bool) {
+	n, ok := m.nodes[key]
+	if !ok {
+		return -1, false
+	}
+	return n.index(), true
+}
+
+// Return a slice containing all of the keys in insertion order
+func (m *IndexedOrderedMap[K, V]) Keys() []K {
+	r := make([]K, 0, m.Len())
+	for k := range m.All() {
+		r = append(r, k)
+	}
+	return r
+}
+
+// Return a slice containing all of the values in insertion order
+func (m *IndexedOrderedMap[K, V]) Values() []V {
+	r := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		r = append(r, v)
+	}
+	return r
+}
+
+// Iterate over the map in insertion order calling function f on each key/value
+// pair
+func (m *IndexedOrderedMap[K, V]) Range(f func(K, V)) {
+	for k, v := range m.All() {
+		f(k, v)
+	}
+}
+
+// Return an iterator over the key/value pairs in insertion order
+func (m *IndexedOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Slice(0, m.Len())
+}
+
+// Return an iterator over the key/value pairs in reverse insertion order
+func (m *IndexedOrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := m.nodeAt(m.Len() - 1); n != nil; n = n.prev() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator over the key/value pairs at positions [from, to) in
+// insertion order. The range is clamped to [0, Len()). Locating the first pair
+// is O(log N) and each step after it is amortized O(1).
+func (m *IndexedOrderedMap[K, V]) Slice(from, to int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		from, to := max(from, 0), min(to, m.Len())
+		n := m.nodeAt(from)
+		for i := from; i < to; i++ {
+			if !yield(n.key, n.value) {
+				return
+			}
+			n = n.next()
+		}
+	}
+}
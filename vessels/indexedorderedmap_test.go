@@ -0,0 +1,197 @@
+package vessels
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+// verify the AVL balance, sizes and parent pointers of every node
+func checkOrderNode[K comparable, V any](t *testing.T, n *orderNode[K, V]) {
+	t.Helper()
+	if n == nil {
+		return
+	}
+	for _, c := range []*orderNode[K, V]{n.left, n.right} {
+		if c != nil && c.parent != n {
+			t.Fatalf("%s failed: bad parent at %v", t.Name(), c.key)
+		}
+		checkOrderNode(t, c)
+	}
+	bf := n.left.getHeight() - n.right.getHeight()
+	if bf < -1 || bf > 1 {
+		t.Fatalf("%s failed: unbalanced node %v: %v", t.Name(), n.key, bf)
+	}
+	if n.height != 1+max(n.left.getHeight(), n.right.getHeight()) ||
+		n.size != 1+n.left.getSize()+n.right.getSize() {
+		t.Fatalf("%s failed: stale node %v", t.Name(), n.key)
+	}
+}
+
+func makeTestIOM(keys ...string) *IndexedOrderedMap[string, int] {
+	m := NewIndexedOrderedMap[string, int]()
+	for i, k := range keys {
+		m.Insert(k, i)
+	}
+	return m
+}
+
+func TestNewIndexedOrderedMap(t *testing.T) {
+	m := NewIndexedOrderedMap[int, int]()
+	expect(t, m.Len(), 0)
+	m = NewIndexedOrderedMap[int, int](10)
+	expect(t, m.Len(), 0)
+}
+
+func TestIOMInsert(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestIOM("c", "a", "b")
+	x.Expect(m.Len()).ToBe(3)
+	x.ExpectOk(m.First()).ToBe("c")
+	x.ExpectOk(m.Last()).ToBe("b")
+	x.ExpectOk(m.Value("a")).ToBe(1)
+	m.Insert("c", 9)
+	x.Expect(m.Keys()).ToBe([]string{"c", "a", "b"})
+	x.ExpectOk(m.Value("c")).ToBe(9)
+	x.ExpectNotOk(m.Value("z"))
+	x.Expect(m.Contains("a")).ToBe(true)
+	x.Expect(m.Contains("z")).ToBe(false)
+}
+
+func TestIOMInsertAt(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestIOM("a", "b", "c")
+	x.Expect(m.InsertAt(1, "x", 10)).ToBe(true)
+	x.Expect(m.InsertAt(0, "y", 11)).ToBe(true)
+	x.Expect(m.InsertAt(5, "z", 12)).ToBe(true)
+	x.Expect(m.InsertAt(7, "w", 13)).ToBe(false)
+	x.Expect(m.InsertAt(-1, "w", 13)).ToBe(false)
+	x.Expect(m.InsertAt(0, "a", 13)).ToBe(false)
+	x.Expect(m.Keys()).ToBe([]string{"y", "a", "x", "b", "c", "z"})
+	x.Expect(m.Values()).ToBe([]int{11, 0, 10, 1, 2, 12})
+	checkOrderNode(t, m.root)
+}
+
+func TestIOMDelete(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestIOM("a", "b", "c", "d", "e")
+	x.Expect(m.Delete("b")).ToBe(true)
+	x.Expect(m.Delete("b")).ToBe(false)
+	x.ExpectOk(m.DeleteAt(0)).ToBe("a")
+	x.ExpectNotOk(m.DeleteAt(3))
+	x.Expect(m.Keys()).ToBe([]string{"c", "d", "e"})
+	checkOrderNode(t, m.root)
+}
+
+func TestIOMPushPop(t *testing.T) {
+	x := expected.New(t)
+	m := NewIndexedOrderedMap[int, int]()
+	m.Push(1, 9)
+	m.Push(2, 8)
+	x.ExpectOk(m.Pop()).ToBe(2)
+	x.ExpectOk(m.Pop()).ToBe(1)
+	x.ExpectNotOk(m.Pop())
+	x.ExpectNotOk(m.First())
+	x.ExpectNotOk(m.Last())
+}
+
+func TestIOMNextPrev(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestIOM("c", "a", "b", "e", "d")
+	x.ExpectOk(m.Next("c")).ToBe("a")
+	x.ExpectOk(m.Next("b")).ToBe("e")
+	x.ExpectNotOk(m.Next("d"))
+	x.ExpectNotOk(m.Next("z"))
+	x.ExpectOk(m.Prev("e")).ToBe("b")
+	x.ExpectNotOk(m.Prev("c"))
+	x.ExpectNotOk(m.Prev("z"))
+}
+
+func TestIOMAtIndexOf(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestIOM("c", "a", "b", "e", "d")
+	x.ExpectOk(m.At(2)).ToBe(2)
+	x.ExpectOk(m.KeyAt(3)).ToBe("e")
+	x.ExpectNotOk(m.At(5))
+	x.ExpectNotOk(m.KeyAt(-1))
+	x.ExpectOk(m.IndexOf("d")).ToBe(4)
+	x.ExpectOk(m.IndexOf("c")).ToBe(0)
+	x.ExpectNotOk(m.IndexOf("z"))
+}
+
+func TestIOMClear(t *testing.T) {
+	m := makeTestIOM("a", "b")
+	m.Clear()
+	expect(t, m.Len(), 0)
+	expect(t, m.Keys(), []string{})
+	m.Insert("c", 1)
+	expect(t, m.Keys(), []string{"c"})
+}
+
+func TestIOMIterators(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestIOM("c", "a", "b", "e", "d")
+	keys := []string{}
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]string{"d", "e", "b", "a", "c"})
+
+	keys = keys[:0]
+	for k := range m.Slice(1, 4) {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]string{"a", "b", "e"})
+
+	keys = keys[:0]
+	for k := range m.Slice(-2, 9) {
+		if k == "e" {
+			break
+		}
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]string{"c", "a", "b"})
+
+	keys = keys[:0]
+	for k := range m.Slice(3, 1) {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]string{})
+
+	values := []int{}
+	m.Range(func(_ string, v int) {
+		values = append(values, v)
+	})
+	x.Expect(values).ToBe([]int{0, 1, 2, 3, 4})
+}
+
+func TestIOMRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewIndexedOrderedMap[int, int]()
+	ref := []int{}
+	for i := 0; i < 3000; i++ {
+		switch op := r.Intn(4); {
+		case op == 0 && len(ref) > 0:
+			j := r.Intn(len(ref))
+			expect(t, m.Delete(ref[j]), true)
+			ref = slices.Delete(ref, j, j+1)
+		case op == 1:
+			j := r.Intn(len(ref) + 1)
+			expect(t, m.InsertAt(j, i, i), true)
+			ref = slices.Insert(ref, j, i)
+		default:
+			m.Insert(i, i)
+			ref = append(ref, i)
+		}
+	}
+	checkOrderNode(t, m.root)
+	expect(t, m.Keys(), ref)
+	for i, k := range ref {
+		index, _ := m.IndexOf(k)
+		expect(t, index, i)
+		v, _ := m.At(i)
+		expect(t, v, k)
+	}
+}
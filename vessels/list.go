@@ -1,6 +1,9 @@
 package vessels
 
-import "cmp"
+import (
+	"cmp"
+	"iter"
+)
 
 // List node holds a single value and pointers to the next and prev nodes
 // The list head is a node where:
@@ -420,3 +423,38 @@ func (list *List[T]) Range(f func(v T)) {
 		f(p.value)
 	}
 }
+
+// Create a new list containing the values of seq in order
+func CollectList[T any](seq iter.Seq[T]) *List[T] {
+	list := NewList[T]()
+	for v := range seq {
+		list.PushBack(v)
+	}
+	return list
+}
+
+// Return an iterator over the index/value pairs of the list from front to back
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for p := list.Begin(); p != list.End(); p = p.Next() {
+			if !yield(i, p.value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Return an iterator over the index/value pairs of the list from back to front
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := list.Len() - 1
+		for p := list.End().Prev(); p != list.End(); p = p.Prev() {
+			if !yield(i, p.value) {
+				return
+			}
+			i--
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package vessels
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/clayessex/algo/expected"
@@ -426,3 +427,46 @@ func TestListRange(t *testing.T) {
 	})
 	expect(t, sum, 10)
 }
+
+func TestListAll(t *testing.T) {
+	list := NewList[int]()
+	list.Append(9, 8, 7)
+	indexes := []int{}
+	values := []int{}
+	for i, v := range list.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	expect(t, indexes, []int{0, 1, 2})
+	expect(t, values, []int{9, 8, 7})
+
+	values = values[:0]
+	for _, v := range list.All() {
+		if v == 7 {
+			break
+		}
+		values = append(values, v)
+	}
+	expect(t, values, []int{9, 8})
+}
+
+func TestListBackward(t *testing.T) {
+	list := NewList[int]()
+	list.Append(9, 8, 7)
+	indexes := []int{}
+	values := []int{}
+	for i, v := range list.Backward() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	expect(t, indexes, []int{2, 1, 0})
+	expect(t, values, []int{7, 8, 9})
+}
+
+func TestCollectList(t *testing.T) {
+	list := CollectList(slices.Values([]int{1, 2, 3}))
+	expect(t, list.Len(), 3)
+	expect(t, list.Values(), []int{1, 2, 3})
+	list = CollectList(slices.Values([]int{}))
+	expect(t, list.Len(), 0)
+}
@@ -0,0 +1,181 @@
+package vessels
+
+import "iter"
+
+// LRU is a fixed capacity cache that evicts the least recently used entry when
+// a new key is added to a full cache. Like OrderedMap it pairs a map with a
+// List of keys, here ordered from most to least recently used. All operations
+// are O(1) except Resize, which is O(number of evicted entries).
+type LRU[K comparable, V any] struct {
+	data     map[K]V            // map keys to values
+	nodes    map[K]*ListNode[K] // map keys to ListNodes
+	ord      List[K]            // keys from most to least recently used
+	capacity int
+	onEvict  func(key K, value V)
+	hits     int
+	misses   int
+}
+
+// Create a new LRU cache holding at most capacity entries. A capacity less than
+// 1 is treated as 1.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	capacity = max(capacity, 1)
+	return &LRU[K, V]{
+		data:     make(map[K]V, capacity),
+		nodes:    make(map[K]*ListNode[K], capacity),
+		ord:      *NewList[K](),
+		capacity: capacity,
+	}
+}
+
+// move the node to the front of the list, making it the most recently used
+func (c *LRU[K, V]) promote(n *ListNode[K]) {
+	splice(c.ord.Begin(), n, n.next)
+}
+
+// remove the least recently used entry and report it to the eviction callback
+func (c *LRU[K, V]) evict() {
+	key, _ := c.ord.PopBack()
+	value := c.data[key]
+	delete(c.nodes, key)
+	delete(c.data, key)
+	if c.onEvict != nil {
+		c.onEvict(key, value)
+	}
+}
+
+// Set a function to be called with each entry evicted to make room for a new
+// one or by Resize. Entries removed by Remove or Clear are not reported. Pass
+// nil to remove the callback.
+func (c *LRU[K, V]) OnEvict(f func(key K, value V)) {
+	c.onEvict = f
+}
+
+// Returns the number of entries in the cache
+func (c *LRU[K, V]) Len() int {
+	return len(c.data)
+}
+
+// Returns the maximum number of entries the cache will hold
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Returns true if the cache contains the key without marking it as used
+func (c *LRU[K, V]) Contains(key K) bool {
+	_, ok := c.data[key]
+	return ok
+}
+
+// Returns the value for the given key and marks it as the most recently used
+// if the key exists, otherwise a default initialized value and false. Counts
+// as a hit or a miss in the statistics.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	n, ok := c.nodes[key]
+	if !ok {
+		c.misses++
+		var zero V
+		return zero, false
+	}
+	c.hits++
+	c.promote(n)
+	return c.data[key], true
+}
+
+// Returns the value for the given key if the key exists, otherwise a default
+// initialized value and false. Does not mark the key as used or update the
+// statistics.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	v, ok := c.data[key]
+	return v, ok
+}
+
+// Insert or update the key/value pair and mark it as the most recently used.
+// Returns true if the least recently used entry was evicted to make room.
+func (c *LRU[K, V]) Put(key K, value V) bool {
+	if n, ok := c.nodes[key]; ok {
+		c.promote(n)
+		c.data[key] = value
+		return false
+	}
+	evicted := false
+	if len(c.data) >= c.capacity {
+		c.evict()
+		evicted = true
+	}
+	c.nodes[key] = c.ord.PushFront(key)
+	c.data[key] = value
+	return evicted
+}
+
+// Remove the entry for the given key. Returns false if the key does not exist
+func (c *LRU[K, V]) Remove(key K) bool {
+	n, ok := c.nodes[key]
+	if !ok {
+		return false
+	}
+	c.ord.RemoveNode(n)
+	delete(c.nodes, key)
+	delete(c.data, key)
+	return true
+}
+
+// Change the capacity of the cache, evicting the least recently used entries
+// that no longer fit. A capacity less than 1 is treated as 1. Returns the
+// number of entries evicted.
+func (c *LRU[K, V]) Resize(capacity int) int {
+	c.capacity = max(capacity, 1)
+	count := 0
+	for len(c.data) > c.capacity {
+		c.evict()
+		count++
+	}
+	return count
+}
+
+// Returns the least recently used key unless the cache is empty, then it
+// returns a default initialized value and false
+func (c *LRU[K, V]) Oldest() (K, bool) {
+	return c.ord.Back()
+}
+
+// Returns the most recently used key unless the cache is empty, then it returns
+// a default initialized value and false
+func (c *LRU[K, V]) Newest() (K, bool) {
+	return c.ord.Front()
+}
+
+// Remove all entries from the cache without reporting them as evicted. The
+// statistics are kept.
+func (c *LRU[K, V]) Clear() {
+	clear(c.data)
+	clear(c.nodes)
+	c.ord.Clear()
+}
+
+// Return a slice containing all of the keys from most to least recently used
+func (c *LRU[K, V]) Keys() []K {
+	return c.ord.Values()
+}
+
+// Return an iterator over the key/value pairs from most to least recently
+// used without marking them as used
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := c.ord.Begin(); p != c.ord.End(); p = p.Next() {
+			if !yield(p.value, c.data[p.value]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the number of Get calls that found and missed their key
+func (c *LRU[K, V]) Stats() (hits, misses int) {
+	return c.hits, c.misses
+}
+
+// Reset the hit and miss counts to zero
+func (c *LRU[K, V]) ResetStats() {
+	c.hits, c.misses = 0, 0
+}
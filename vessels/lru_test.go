@@ -0,0 +1,151 @@
+package vessels
+
+import (
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+func TestNewLRU(t *testing.T) {
+	c := NewLRU[string, int](3)
+	expect(t, c.Len(), 0)
+	expect(t, c.Cap(), 3)
+	expect(t, NewLRU[string, int](0).Cap(), 1)
+}
+
+func TestLRUPutGet(t *testing.T) {
+	x := expected.New(t)
+	c := NewLRU[string, int](2)
+	x.Expect(c.Put("a", 1)).ToBe(false)
+	x.Expect(c.Put("b", 2)).ToBe(false)
+	x.ExpectOk(c.Get("a")).ToBe(1) // a is now most recent
+	x.Expect(c.Put("c", 3)).ToBe(true)
+	x.Expect(c.Contains("b")).ToBe(false)
+	x.Expect(c.Keys()).ToBe([]string{"c", "a"})
+	x.ExpectNotOk(c.Get("b"))
+
+	x.Expect(c.Put("a", 9)).ToBe(false) // update promotes
+	x.Expect(c.Keys()).ToBe([]string{"a", "c"})
+	x.ExpectOk(c.Peek("a")).ToBe(9)
+}
+
+func TestLRUPeek(t *testing.T) {
+	x := expected.New(t)
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	x.ExpectOk(c.Peek("a")).ToBe(1) // does not promote
+	x.ExpectNotOk(c.Peek("z"))
+	c.Put("c", 3)
+	x.Expect(c.Contains("a")).ToBe(false)
+	hits, misses := c.Stats()
+	x.Expect([]int{hits, misses}).ToBe([]int{0, 0})
+}
+
+func TestLRURemove(t *testing.T) {
+	x := expected.New(t)
+	c := NewLRU[string, int](2)
+	evicted := 0
+	c.OnEvict(func(string, int) { evicted++ })
+	c.Put("a", 1)
+	c.Put("b", 2)
+	x.Expect(c.Remove("a")).ToBe(true)
+	x.Expect(c.Remove("a")).ToBe(false)
+	x.Expect(c.Len()).ToBe(1)
+	x.Expect(c.Put("c", 3)).ToBe(false)
+	x.Expect(evicted).ToBe(0)
+}
+
+func TestLRUOnEvict(t *testing.T) {
+	c := NewLRU[string, int](2)
+	keys := []string{}
+	values := []int{}
+	c.OnEvict(func(k string, v int) {
+		keys = append(keys, k)
+		values = append(values, v)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("b")
+	c.Put("d", 4)
+	expect(t, keys, []string{"a", "c"})
+	expect(t, values, []int{1, 3})
+
+	c.OnEvict(nil)
+	c.Put("e", 5)
+	expect(t, keys, []string{"a", "c"})
+}
+
+func TestLRUResize(t *testing.T) {
+	x := expected.New(t)
+	c := NewLRU[int, int](4)
+	evicted := []int{}
+	c.OnEvict(func(k int, _ int) { evicted = append(evicted, k) })
+	for i := 0; i < 4; i++ {
+		c.Put(i, i)
+	}
+	c.Get(0)
+	x.Expect(c.Resize(2)).ToBe(2)
+	x.Expect(evicted).ToBe([]int{1, 2})
+	x.Expect(c.Keys()).ToBe([]int{0, 3})
+	x.Expect(c.Resize(8)).ToBe(0)
+	x.Expect(c.Cap()).ToBe(8)
+	c.Put(9, 9)
+	x.Expect(c.Len()).ToBe(3)
+}
+
+func TestLRUOldestNewest(t *testing.T) {
+	x := expected.New(t)
+	c := NewLRU[int, int](3)
+	x.ExpectNotOk(c.Oldest())
+	x.ExpectNotOk(c.Newest())
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(1)
+	x.ExpectOk(c.Oldest()).ToBe(2)
+	x.ExpectOk(c.Newest()).ToBe(1)
+}
+
+func TestLRUStats(t *testing.T) {
+	c := NewLRU[int, int](3)
+	c.Put(1, 1)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	hits, misses := c.Stats()
+	expect(t, hits, 2)
+	expect(t, misses, 1)
+	c.ResetStats()
+	hits, misses = c.Stats()
+	expect(t, hits+misses, 0)
+}
+
+func TestLRUClear(t *testing.T) {
+	c := NewLRU[int, int](3)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Clear()
+	expect(t, c.Len(), 0)
+	expect(t, c.Keys(), []int{})
+	c.Put(3, 3)
+	expect(t, c.Keys(), []int{3})
+}
+
+func TestLRUAll(t *testing.T) {
+	c := NewLRU[int, string](3)
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Put(3, "c")
+	keys := []int{}
+	values := []string{}
+	for k, v := range c.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	expect(t, keys, []int{3, 2, 1})
+	expect(t, values, []string{"c", "b", "a"})
+	hits, _ := c.Stats()
+	expect(t, hits, 0)
+}
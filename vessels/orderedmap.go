@@ -1,7 +1,10 @@
 package vessels
 
+import "iter"
+
 // OrderedMap is a map that remembers the insertion order of elements. All operations
 // are O(1) except the At() function, which is O(N).
+// IndexedOrderedMap provides O(log N) positional access.
 type OrderedMap[K comparable, V any] struct {
 	data  map[K]V            // map keys to values
 	nodes map[K]*ListNode[K] // map keys to ListNodes
@@ -166,3 +169,36 @@ func (m *OrderedMap[K, V]) Range(f func(K, V)) {
 		f(key, value)
 	})
 }
+
+// Create a new OrderedMap from the key/value pairs of seq, keeping the order in
+// which each key was first seen. Later values for a repeated key overwrite
+// earlier ones.
+func CollectOrderedMap[K comparable, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	m := NewOrderedMap[K, V]()
+	for k, v := range seq {
+		m.Insert(k, v)
+	}
+	return m
+}
+
+// Return an iterator over the key/value pairs in insertion order
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := m.ord.Begin(); p != m.ord.End(); p = p.Next() {
+			if !yield(p.value, m.data[p.value]) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator over the key/value pairs in reverse insertion order
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := m.ord.End().Prev(); p != m.ord.End(); p = p.Prev() {
+			if !yield(p.value, m.data[p.value]) {
+				return
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package vessels
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/clayessex/algo/expected"
@@ -176,3 +177,53 @@ func TestOMRange(t *testing.T) {
 	x.Expect(keys).ToBe([]int{1, 2, 3})
 	x.Expect(values).ToBe([]int{9, 8, 7})
 }
+
+func TestOMAll(t *testing.T) {
+	x := expected.New(t)
+	m := NewOrderedMap[int, int]()
+	m.Push(3, 9)
+	m.Push(1, 8)
+	m.Push(2, 7)
+	keys := []int{}
+	values := []int{}
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	x.Expect(keys).ToBe([]int{3, 1, 2})
+	x.Expect(values).ToBe([]int{9, 8, 7})
+
+	keys = keys[:0]
+	for k := range m.All() {
+		if k == 2 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{3, 1})
+}
+
+func TestOMBackward(t *testing.T) {
+	x := expected.New(t)
+	m := NewOrderedMap[int, int]()
+	m.Push(3, 9)
+	m.Push(1, 8)
+	m.Push(2, 7)
+	keys := []int{}
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{2, 1, 3})
+}
+
+func TestCollectOrderedMap(t *testing.T) {
+	x := expected.New(t)
+	m := CollectOrderedMap(slices.All([]string{"a", "b", "c"}))
+	x.Expect(m.Keys()).ToBe([]int{0, 1, 2})
+	x.Expect(m.Values()).ToBe([]string{"a", "b", "c"})
+
+	src := NewOrderedMap[string, int]()
+	src.Push("z", 1)
+	src.Push("a", 2)
+	x.Expect(CollectOrderedMap(src.All()).Keys()).ToBe([]string{"z", "a"})
+}
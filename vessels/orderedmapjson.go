@@ -0,0 +1,136 @@
+package vessels
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Encode the map as a JSON object with the members in insertion order. Keys
+// follow the encoding/json rules for map keys: string kinds are used directly,
+// encoding.TextMarshaler keys are marshaled as text and integer kinds are
+// formatted in base 10.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	if m.data != nil {
+		for p := m.ord.Begin(); p != m.ord.End(); p = p.Next() {
+			if p != m.ord.Begin() {
+				buf.WriteByte(',')
+			}
+			name, err := encodeJSONKey(p.value)
+			if err != nil {
+				return nil, err
+			}
+			key, err := json.Marshal(name)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			value, err := json.Marshal(m.data[p.value])
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(value)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Decode a JSON object into the map, replacing its contents. Members are
+// inserted in document order. When a key appears more than once the last value
+// wins and the key keeps the position of its first appearance, the same as
+// calling Insert for each member. A JSON null leaves the map unchanged.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("vessels: cannot unmarshal %v into OrderedMap", tok)
+	}
+
+	if m.data == nil {
+		*m = *NewOrderedMap[K, V]()
+	} else {
+		m.Clear()
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeJSONKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		m.Insert(key, value)
+	}
+	_, err = dec.Token() // closing '}'
+	return err
+}
+
+// Convert a map key to a JSON object member name
+func encodeJSONKey[K any](key K) (string, error) {
+	v := reflect.ValueOf(&key).Elem()
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return "", nil
+		}
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("vessels: unsupported JSON key type %v", v.Type())
+}
+
+// Convert a JSON object member name to a map key
+func decodeJSONKey[K any](name string) (K, error) {
+	var key K
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(name))
+		return key, err
+	}
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("vessels: invalid JSON key %q: %w", name, err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("vessels: invalid JSON key %q: %w", name, err)
+		}
+		v.SetUint(n)
+	default:
+		return key, fmt.Errorf("vessels: unsupported JSON key type %v", v.Type())
+	}
+	return key, nil
+}
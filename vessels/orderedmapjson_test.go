@@ -0,0 +1,107 @@
+package vessels
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+func TestOMMarshalJSON(t *testing.T) {
+	x := expected.New(t)
+	m := NewOrderedMap[string, int]()
+	m.Insert("zebra", 1)
+	m.Insert("apple", 2)
+	m.Insert("mango", 3)
+	x.ExpectErrNil(json.Marshal(m)).ToBe([]byte(`{"zebra":1,"apple":2,"mango":3}`))
+
+	x.ExpectErrNil(json.Marshal(NewOrderedMap[string, int]())).ToBe([]byte(`{}`))
+	x.ExpectErrNil(json.Marshal(&OrderedMap[string, int]{})).ToBe([]byte(`{}`))
+
+	n := NewOrderedMap[int8, []string]()
+	n.Insert(-3, []string{"a"})
+	n.Insert(7, nil)
+	x.ExpectErrNil(json.Marshal(n)).ToBe([]byte(`{"-3":["a"],"7":null}`))
+
+	a := NewOrderedMap[netip.Addr, bool]()
+	a.Insert(netip.MustParseAddr("10.0.0.2"), true)
+	a.Insert(netip.MustParseAddr("10.0.0.1"), false)
+	x.ExpectErrNil(json.Marshal(a)).ToBe([]byte(`{"10.0.0.2":true,"10.0.0.1":false}`))
+
+	f := NewOrderedMap[float64, int]()
+	f.Insert(1.5, 1)
+	x.ExpectErr(json.Marshal(f))
+}
+
+func TestOMMarshalJSONNested(t *testing.T) {
+	type config struct {
+		Name  string
+		Items *OrderedMap[string, int]
+	}
+	m := NewOrderedMap[string, int]()
+	m.Insert("b", 1)
+	m.Insert("a", 2)
+	data, err := json.Marshal(config{"x", m})
+	expect(t, err, nil)
+	expect(t, string(data), `{"Name":"x","Items":{"b":1,"a":2}}`)
+}
+
+func TestOMUnmarshalJSON(t *testing.T) {
+	x := expected.New(t)
+	m := NewOrderedMap[string, int]()
+	m.Insert("old", 0)
+	err := json.Unmarshal([]byte(` {"zebra": 1, "apple": 2, "mango": 3} `), m)
+	x.Expect(err).ToBe(nil)
+	x.Expect(m.Keys()).ToBe([]string{"zebra", "apple", "mango"})
+	x.Expect(m.Values()).ToBe([]int{1, 2, 3})
+
+	err = json.Unmarshal([]byte(`null`), m)
+	x.Expect(err).ToBe(nil)
+	x.Expect(m.Len()).ToBe(3)
+
+	var zero OrderedMap[uint16, string]
+	err = json.Unmarshal([]byte(`{"10":"a","2":"b"}`), &zero)
+	x.Expect(err).ToBe(nil)
+	x.Expect(zero.Keys()).ToBe([]uint16{10, 2})
+	x.Expect(zero.Values()).ToBe([]string{"a", "b"})
+
+	a := NewOrderedMap[netip.Addr, int]()
+	err = json.Unmarshal([]byte(`{"::1":1,"127.0.0.1":2}`), a)
+	x.Expect(err).ToBe(nil)
+	x.ExpectOk(a.First()).ToBe(netip.MustParseAddr("::1"))
+}
+
+func TestOMUnmarshalJSONDuplicates(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	err := json.Unmarshal([]byte(`{"a":1,"b":2,"a":3}`), m)
+	expect(t, err, nil)
+	expect(t, m.Keys(), []string{"a", "b"})
+	expect(t, m.Values(), []int{3, 2})
+}
+
+func TestOMUnmarshalJSONNested(t *testing.T) {
+	type config struct {
+		Items OrderedMap[string, *OrderedMap[string, int]]
+	}
+	var c config
+	err := json.Unmarshal([]byte(`{"Items":{"y":{"q":1,"p":2},"x":{}}}`), &c)
+	expect(t, err, nil)
+	expect(t, c.Items.Keys(), []string{"y", "x"})
+	inner, _ := c.Items.Value("y")
+	expect(t, inner.Keys(), []string{"q", "p"})
+
+	data, err := json.Marshal(&c)
+	expect(t, err, nil)
+	expect(t, string(data), `{"Items":{"y":{"q":1,"p":2},"x":{}}}`)
+}
+
+func TestOMUnmarshalJSONErrors(t *testing.T) {
+	x := expected.New(t)
+	x.Expect(json.Unmarshal([]byte(`[1,2]`), NewOrderedMap[string, int]()) != nil).ToBe(true)
+	x.Expect(json.Unmarshal([]byte(`{"a":"b"}`), NewOrderedMap[string, int]()) != nil).ToBe(true)
+	x.Expect(json.Unmarshal([]byte(`{"a":1}`), NewOrderedMap[int, int]()) != nil).ToBe(true)
+	x.Expect(json.Unmarshal([]byte(`{"300":1}`), NewOrderedMap[int8, int]()) != nil).ToBe(true)
+	x.Expect(json.Unmarshal([]byte(`{"a":1}`), NewOrderedMap[float32, int]()) != nil).ToBe(true)
+	x.Expect(json.Unmarshal([]byte(`{"a":1`), NewOrderedMap[string, int]()) != nil).ToBe(true)
+}
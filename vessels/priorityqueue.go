@@ -0,0 +1,294 @@
+package vessels
+
+import (
+	"cmp"
+	"slices"
+)
+
+// PriorityQueue is a binary heap. Pop always returns the element that is
+// ordered before all others according to the comparison function, so with
+// cmp.Less the smallest element comes out first. Push and Pop are O(log n),
+// Peek is O(1).
+type PriorityQueue[T any] struct {
+	data []T
+	comp func(a, b T) bool
+}
+
+// Create a new PriorityQueue ordered by cmp.Less (smallest first) with an
+// optional initial allocation size
+func NewPriorityQueue[T cmp.Ordered](size ...int) *PriorityQueue[T] {
+	return NewPriorityQueueFunc(cmp.Less[T], size...)
+}
+
+// Create a new PriorityQueue ordered by comp with an optional initial
+// allocation size. comp is a comparison function that returns true if a should
+// be popped before b
+func NewPriorityQueueFunc[T any](comp func(a, b T) bool, size ...int) *PriorityQueue[T] {
+	sz := INITIAL_DEQUE_SIZE
+	if len(size) > 0 {
+		sz = size[0]
+	}
+	return &PriorityQueue[T]{make([]T, 0, sz), comp}
+}
+
+// Create a new PriorityQueue ordered by cmp.Less holding a copy of the
+// elements of s. Building the heap is O(n)
+func HeapifyPriorityQueue[T cmp.Ordered](s []T) *PriorityQueue[T] {
+	return HeapifyPriorityQueueFunc(s, cmp.Less[T])
+}
+
+// Create a new PriorityQueue ordered by comp holding a copy of the elements of
+// s. Building the heap is O(n)
+func HeapifyPriorityQueueFunc[T any](s []T, comp func(a, b T) bool) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{slices.Clone(s), comp}
+	heapInit(pq)
+	return pq
+}
+
+func (pq *PriorityQueue[T]) len() int {
+	return len(pq.data)
+}
+
+func (pq *PriorityQueue[T]) less(i, j int) bool {
+	return pq.comp(pq.data[i], pq.data[j])
+}
+
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.data[i], pq.data[j] = pq.data[j], pq.data[i]
+}
+
+// Number of elements in the queue
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.data)
+}
+
+// Add an element to the queue
+func (pq *PriorityQueue[T]) Push(v T) {
+	pq.data = append(pq.data, v)
+	heapUp(pq, len(pq.data)-1)
+}
+
+// Remove and return the highest priority element unless the queue is empty,
+// then it returns a default initialized value and false
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	n := len(pq.data) - 1
+	pq.swap(0, n)
+	heapDown(pq, 0, n)
+	v := pq.data[n]
+	var zero T
+	pq.data[n] = zero // release for GC
+	pq.data = pq.data[:n]
+	return v, true
+}
+
+// Return the highest priority element without removing it unless the queue is
+// empty, then it returns a default initialized value and false
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.data[0], true
+}
+
+// Remove all elements from the queue
+func (pq *PriorityQueue[T]) Clear() {
+	clear(pq.data)
+	pq.data = pq.data[:0]
+}
+
+// Return a clone of the queue sharing the same comparison function
+func (pq *PriorityQueue[T]) Clone() *PriorityQueue[T] {
+	return &PriorityQueue[T]{slices.Clone(pq.data), pq.comp}
+}
+
+// PriorityQueueItem is a handle to an element of an IndexedPriorityQueue. It
+// remains valid until the element is popped or removed.
+type PriorityQueueItem[T any] struct {
+	value T
+	index int // position in the heap, -1 once removed
+}
+
+// The element held by the item
+func (it *PriorityQueueItem[T]) Value() T {
+	return it.value
+}
+
+// IndexedPriorityQueue is a PriorityQueue whose elements can be changed or
+// removed after insertion through the handle returned by Push, as needed for
+// decrease-key algorithms such as Dijkstra's shortest path. Push, Pop, Update
+// and Remove are O(log n).
+type IndexedPriorityQueue[T any] struct {
+	data []*PriorityQueueItem[T]
+	comp func(a, b T) bool
+}
+
+// Create a new IndexedPriorityQueue ordered by cmp.Less (smallest first) with
+// an optional initial allocation size
+func NewIndexedPriorityQueue[T cmp.Ordered](size ...int) *IndexedPriorityQueue[T] {
+	return NewIndexedPriorityQueueFunc(cmp.Less[T], size...)
+}
+
+// Create a new IndexedPriorityQueue ordered by comp with an optional initial
+// allocation size. comp is a comparison function that returns true if a should
+// be popped before b
+func NewIndexedPriorityQueueFunc[T any](comp func(a, b T) bool, size ...int) *IndexedPriorityQueue[T] {
+	sz := INITIAL_DEQUE_SIZE
+	if len(size) > 0 {
+		sz = size[0]
+	}
+	return &IndexedPriorityQueue[T]{make([]*PriorityQueueItem[T], 0, sz), comp}
+}
+
+func (pq *IndexedPriorityQueue[T]) len() int {
+	return len(pq.data)
+}
+
+func (pq *IndexedPriorityQueue[T]) less(i, j int) bool {
+	return pq.comp(pq.data[i].value, pq.data[j].value)
+}
+
+func (pq *IndexedPriorityQueue[T]) swap(i, j int) {
+	pq.data[i], pq.data[j] = pq.data[j], pq.data[i]
+	pq.data[i].index = i
+	pq.data[j].index = j
+}
+
+// item belongs to this queue
+func (pq *IndexedPriorityQueue[T]) owns(item *PriorityQueueItem[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(pq.data) &&
+		pq.data[item.index] == item
+}
+
+// remove and return the item at index i
+func (pq *IndexedPriorityQueue[T]) removeAt(i int) *PriorityQueueItem[T] {
+	n := len(pq.data) - 1
+	if i != n {
+		pq.swap(i, n)
+		if !heapDown(pq, i, n) {
+			heapUp(pq, i)
+		}
+	}
+	item := pq.data[n]
+	pq.data[n] = nil
+	pq.data = pq.data[:n]
+	item.index = -1
+	return item
+}
+
+// Number of elements in the queue
+func (pq *IndexedPriorityQueue[T]) Len() int {
+	return len(pq.data)
+}
+
+// Add an element to the queue and return its handle
+func (pq *IndexedPriorityQueue[T]) Push(v T) *PriorityQueueItem[T] {
+	item := &PriorityQueueItem[T]{v, len(pq.data)}
+	pq.data = append(pq.data, item)
+	heapUp(pq, item.index)
+	return item
+}
+
+// Remove and return the highest priority element unless the queue is empty,
+// then it returns a default initialized value and false
+func (pq *IndexedPriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.removeAt(0).value, true
+}
+
+// Return the highest priority element without removing it unless the queue is
+// empty, then it returns a default initialized value and false
+func (pq *IndexedPriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.data[0].value, true
+}
+
+// Replace the element held by item with v and restore the heap order. Returns
+// false if item is not in the queue
+func (pq *IndexedPriorityQueue[T]) Update(item *PriorityQueueItem[T], v T) bool {
+	if !pq.owns(item) {
+		return false
+	}
+	item.value = v
+	if !heapDown(pq, item.index, len(pq.data)) {
+		heapUp(pq, item.index)
+	}
+	return true
+}
+
+// Remove the element held by item from the queue. Returns false if item is not
+// in the queue
+func (pq *IndexedPriorityQueue[T]) Remove(item *PriorityQueueItem[T]) bool {
+	if !pq.owns(item) {
+		return false
+	}
+	pq.removeAt(item.index)
+	return true
+}
+
+// Remove all elements from the queue, invalidating every handle
+func (pq *IndexedPriorityQueue[T]) Clear() {
+	for _, item := range pq.data {
+		item.index = -1
+	}
+	clear(pq.data)
+	pq.data = pq.data[:0]
+}
+
+// heap operations shared by the priority queues
+type heapData interface {
+	len() int
+	less(i, j int) bool
+	swap(i, j int)
+}
+
+// Establish the heap order over all of the elements
+func heapInit[H heapData](h H) {
+	n := h.len()
+	for i := n/2 - 1; i >= 0; i-- {
+		heapDown(h, i, n)
+	}
+}
+
+// Move element j up toward the root until its parent is ordered before it
+func heapUp[H heapData](h H, j int) {
+	for j > 0 {
+		i := (j - 1) / 2 // parent
+		if !h.less(j, i) {
+			break
+		}
+		h.swap(i, j)
+		j = i
+	}
+}
+
+// Move element i0 down within the first n elements until both of its children
+// are ordered after it. Returns true if the element moved
+func heapDown[H heapData](h H, i0, n int) bool {
+	i := i0
+	for {
+		j := 2*i + 1 // left child
+		if j >= n || j < 0 {
+			break
+		}
+		if r := j + 1; r < n && h.less(r, j) {
+			j = r
+		}
+		if !h.less(j, i) {
+			break
+		}
+		h.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
@@ -0,0 +1,192 @@
+package vessels
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+func drainPQ[T any](pq interface{ Pop() (T, bool) }) []T {
+	r := []T{}
+	for v, ok := pq.Pop(); ok; v, ok = pq.Pop() {
+		r = append(r, v)
+	}
+	return r
+}
+
+func TestNewPriorityQueue(t *testing.T) {
+	pq := NewPriorityQueue[int]()
+	expect(t, pq.Len(), 0)
+	pq = NewPriorityQueue[int](4)
+	expect(t, pq.Len(), 0)
+}
+
+func TestPQPushPop(t *testing.T) {
+	x := expected.New(t)
+	pq := NewPriorityQueue[int](2)
+	pq.Push(5)
+	pq.Push(2)
+	pq.Push(8)
+	pq.Push(1)
+	x.Expect(pq.Len()).ToBe(4)
+	x.ExpectOk(pq.Pop()).ToBe(1)
+	x.ExpectOk(pq.Pop()).ToBe(2)
+	x.ExpectOk(pq.Pop()).ToBe(5)
+	x.ExpectOk(pq.Pop()).ToBe(8)
+	x.ExpectNotOk(pq.Pop())
+	x.Expect(pq.Len()).ToBe(0)
+}
+
+func TestPQPeek(t *testing.T) {
+	x := expected.New(t)
+	pq := NewPriorityQueue[int]()
+	x.ExpectNotOk(pq.Peek())
+	pq.Push(5)
+	pq.Push(2)
+	x.ExpectOk(pq.Peek()).ToBe(2)
+	x.Expect(pq.Len()).ToBe(2)
+}
+
+func TestPQFunc(t *testing.T) {
+	pq := NewPriorityQueueFunc(func(a, b string) bool { return len(a) > len(b) })
+	pq.Push("bb")
+	pq.Push("a")
+	pq.Push("cccc")
+	pq.Push("ddd")
+	expect(t, drainPQ[string](pq), []string{"cccc", "ddd", "bb", "a"})
+}
+
+func TestPQHeapify(t *testing.T) {
+	s := []int{9, 3, 7, 1, 8, 2, 2, 6}
+	pq := HeapifyPriorityQueue(s)
+	expect(t, s, []int{9, 3, 7, 1, 8, 2, 2, 6}) // untouched
+	expect(t, drainPQ[int](pq), []int{1, 2, 2, 3, 6, 7, 8, 9})
+
+	pq = HeapifyPriorityQueueFunc(s, func(a, b int) bool { return a > b })
+	expect(t, drainPQ[int](pq), []int{9, 8, 7, 6, 3, 2, 2, 1})
+
+	pq = HeapifyPriorityQueue([]int{})
+	expect(t, pq.Len(), 0)
+}
+
+func TestPQClear(t *testing.T) {
+	pq := HeapifyPriorityQueue([]int{3, 2, 1})
+	pq.Clear()
+	expect(t, pq.Len(), 0)
+	pq.Push(4)
+	expect(t, drainPQ[int](pq), []int{4})
+}
+
+func TestPQClone(t *testing.T) {
+	pq := HeapifyPriorityQueue([]int{3, 2, 1})
+	c := pq.Clone()
+	c.Push(0)
+	expect(t, drainPQ[int](c), []int{0, 1, 2, 3})
+	expect(t, drainPQ[int](pq), []int{1, 2, 3})
+}
+
+func TestPQRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := make([]int, 1000)
+	pq := NewPriorityQueue[int]()
+	for i := range s {
+		s[i] = r.Intn(100)
+		pq.Push(s[i])
+	}
+	slices.Sort(s)
+	expect(t, drainPQ[int](pq), s)
+}
+
+func TestIPQPushPop(t *testing.T) {
+	x := expected.New(t)
+	pq := NewIndexedPriorityQueue[int]()
+	x.ExpectNotOk(pq.Pop())
+	x.ExpectNotOk(pq.Peek())
+	a := pq.Push(5)
+	pq.Push(2)
+	pq.Push(8)
+	x.Expect(a.Value()).ToBe(5)
+	x.Expect(pq.Len()).ToBe(3)
+	x.ExpectOk(pq.Peek()).ToBe(2)
+	x.Expect(drainPQ[int](pq)).ToBe([]int{2, 5, 8})
+}
+
+func TestIPQUpdate(t *testing.T) {
+	x := expected.New(t)
+	pq := NewIndexedPriorityQueue[int]()
+	a := pq.Push(5)
+	b := pq.Push(2)
+	c := pq.Push(8)
+	pq.Push(6)
+	x.Expect(pq.Update(c, 1)).ToBe(true) // decrease
+	x.ExpectOk(pq.Peek()).ToBe(1)
+	x.Expect(pq.Update(b, 9)).ToBe(true) // increase
+	x.Expect(c.Value()).ToBe(1)
+	x.Expect(drainPQ[int](pq)).ToBe([]int{1, 5, 6, 9})
+	x.Expect(pq.Update(a, 3)).ToBe(false) // popped
+}
+
+func TestIPQRemove(t *testing.T) {
+	x := expected.New(t)
+	pq := NewIndexedPriorityQueueFunc(func(a, b int) bool { return a > b })
+	items := []*PriorityQueueItem[int]{}
+	for _, v := range []int{4, 9, 1, 7, 3, 8} {
+		items = append(items, pq.Push(v))
+	}
+	x.Expect(pq.Remove(items[1])).ToBe(true)
+	x.Expect(pq.Remove(items[1])).ToBe(false)
+	x.Expect(pq.Remove(items[4])).ToBe(true)
+	x.Expect(pq.Len()).ToBe(4)
+	x.Expect(drainPQ[int](pq)).ToBe([]int{8, 7, 4, 1})
+
+	other := NewIndexedPriorityQueue[int]()
+	other.Push(1)
+	x.Expect(pq.Remove(other.Push(2))).ToBe(false)
+	x.Expect(pq.Remove(nil)).ToBe(false)
+}
+
+func TestIPQClear(t *testing.T) {
+	pq := NewIndexedPriorityQueue[int]()
+	a := pq.Push(1)
+	pq.Clear()
+	expect(t, pq.Len(), 0)
+	expect(t, pq.Update(a, 2), false)
+}
+
+func TestIPQDijkstra(t *testing.T) {
+	type vertex struct {
+		id   int
+		dist int
+	}
+	// edges[from] = [][2]int{to, weight}
+	edges := [][][2]int{
+		{{1, 4}, {2, 1}},
+		{{3, 1}},
+		{{1, 2}, {3, 5}},
+		{},
+	}
+	pq := NewIndexedPriorityQueueFunc(func(a, b vertex) bool { return a.dist < b.dist })
+	const inf = 1 << 30
+	handles := make([]*PriorityQueueItem[vertex], len(edges))
+	for i := range edges {
+		d := inf
+		if i == 0 {
+			d = 0
+		}
+		handles[i] = pq.Push(vertex{i, d})
+	}
+	dist := make([]int, len(edges))
+	for pq.Len() > 0 {
+		u, _ := pq.Pop()
+		dist[u.id] = u.dist
+		for _, e := range edges[u.id] {
+			h := handles[e[0]]
+			if nd := u.dist + e[1]; nd < h.Value().dist {
+				pq.Update(h, vertex{e[0], nd})
+			}
+		}
+	}
+	expect(t, dist, []int{0, 3, 1, 4})
+}
@@ -1,5 +1,7 @@
 package vessels
 
+import "iter"
+
 type Queue[T any] Deque[T]
 
 func NewQueue[T any](size ...int) *Queue[T] {
@@ -33,3 +35,19 @@ func (q *Queue[T]) Clear() {
 func (q *Queue[T]) Clone() *Queue[T] {
 	return (*Queue[T])((*Deque[T])(q).Clone())
 }
+
+func CollectQueue[T any](seq iter.Seq[T]) *Queue[T] {
+	return (*Queue[T])(CollectDeque(seq))
+}
+
+func (q *Queue[T]) All() iter.Seq2[int, T] {
+	return (*Deque[T])(q).All()
+}
+
+func (q *Queue[T]) Backward() iter.Seq2[int, T] {
+	return (*Deque[T])(q).Backward()
+}
+
+func (q *Queue[T]) Values() iter.Seq[T] {
+	return (*Deque[T])(q).Values()
+}
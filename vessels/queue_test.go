@@ -1,6 +1,7 @@
 package vessels
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/clayessex/algo/expected"
@@ -88,3 +89,19 @@ func TestQueueClone(t *testing.T) {
 	x.Expect(c.Len()).ToBe(0)
 	x.Expect(d.Len()).ToBe(3)
 }
+
+func TestQueueIterators(t *testing.T) {
+	q := CollectQueue(slices.Values([]int{9, 8, 7}))
+	expect(t, q.Len(), 3)
+	expect(t, slices.Collect(q.Values()), []int{9, 8, 7})
+	values := []int{}
+	for _, v := range q.Backward() {
+		values = append(values, v)
+	}
+	expect(t, values, []int{7, 8, 9})
+	indexes := []int{}
+	for i := range q.All() {
+		indexes = append(indexes, i)
+	}
+	expect(t, indexes, []int{0, 1, 2})
+}
@@ -1,6 +1,9 @@
 package vessels
 
-import "maps"
+import (
+	"iter"
+	"maps"
+)
 
 type Set[T comparable] map[T]struct{}
 
@@ -166,3 +169,17 @@ func (s Set[T]) ForEach(f func(T)) {
 		f(el)
 	}
 }
+
+// Create a new Set containing the elements of seq
+func CollectSet[T comparable](seq iter.Seq[T]) Set[T] {
+	s := NewSet[T]()
+	for el := range seq {
+		s[el] = struct{}{}
+	}
+	return s
+}
+
+// Return an iterator over the elements of the Set in no particular order
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
@@ -154,3 +154,21 @@ func TestSetRangeable(t *testing.T) {
 	slices.Sort(x)
 	expect(t, x, []int{1, 2, 3})
 }
+
+func TestSetAll(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	x := slices.Sorted(s.All())
+	expect(t, x, []int{1, 2, 3})
+	count := 0
+	for range s.All() {
+		count++
+		break
+	}
+	expect(t, count, 1)
+}
+
+func TestCollectSet(t *testing.T) {
+	s := CollectSet(slices.Values([]int{1, 2, 2, 3}))
+	expect(t, s.Len(), 3)
+	expect(t, s.ContainsAll(1, 2, 3), true)
+}
@@ -0,0 +1,267 @@
+package vessels
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SortedSet is a Set that keeps its elements in order. It has the same API as
+// Set plus ordered queries, and is backed by a TreeMap so membership tests,
+// insertion and deletion are O(log N). The set algebra functions merge the
+// sorted elements in linear time.
+type SortedSet[T any] struct {
+	m *TreeMap[T, struct{}]
+}
+
+// Create a new SortedSet ordered by cmp.Less and (optionally) fill it with the
+// given elements
+func NewSortedSet[T cmp.Ordered](elements ...T) *SortedSet[T] {
+	return NewSortedSetFunc(cmp.Less[T], elements...)
+}
+
+// Create a new SortedSet ordered by comp and (optionally) fill it with the
+// given elements
+// comp is a comparison function that returns true if a is ordered before b
+func NewSortedSetFunc[T any](comp func(a, b T) bool, elements ...T) *SortedSet[T] {
+	s := &SortedSet[T]{NewTreeMapFunc[T, struct{}](comp)}
+	s.Append(elements...)
+	return s
+}
+
+// Create a new SortedSet with the ordering of s holding the given elements,
+// which must already be sorted and unique
+func (s *SortedSet[T]) fromSorted(elements []T) *SortedSet[T] {
+	return &SortedSet[T]{&TreeMap[T, struct{}]{buildTreeNodes[T, struct{}](elements, nil), s.m.comp}}
+}
+
+// a and b are equivalent under the set ordering
+func (s *SortedSet[T]) equiv(a, b T) bool {
+	return !s.m.comp(a, b) && !s.m.comp(b, a)
+}
+
+// Number of elements in the SortedSet
+func (s *SortedSet[T]) Len() int {
+	return s.m.Len()
+}
+
+// True if the SortedSet contains el
+func (s *SortedSet[T]) Contains(el T) bool {
+	return s.m.Contains(el)
+}
+
+// True if the SortedSet contains each of the given elements
+func (s *SortedSet[T]) ContainsAll(elements ...T) bool {
+	for _, el := range elements {
+		if !s.m.Contains(el) {
+			return false
+		}
+	}
+	return true
+}
+
+// True if the SortedSet contains any of the given elements
+func (s *SortedSet[T]) ContainsAny(elements ...T) bool {
+	for _, el := range elements {
+		if s.m.Contains(el) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add an element to the SortedSet
+func (s *SortedSet[T]) Add(el T) {
+	s.m.Insert(el, struct{}{})
+}
+
+// Add several elements to the SortedSet
+func (s *SortedSet[T]) Append(elements ...T) {
+	for _, el := range elements {
+		s.m.Insert(el, struct{}{})
+	}
+}
+
+// Remove an element from the SortedSet
+func (s *SortedSet[T]) Delete(el T) {
+	s.m.Delete(el)
+}
+
+// Remove all elements from the SortedSet
+func (s *SortedSet[T]) Clear() {
+	s.m.Clear()
+}
+
+// Create a slice of type T containing all of the elements in sorted order
+func (s *SortedSet[T]) Keys() []T {
+	return s.m.Keys()
+}
+
+// Create a slice of type T containing all of the elements in sorted order
+// Alias for Keys()
+func (s *SortedSet[T]) Values() []T {
+	return s.m.Keys()
+}
+
+// Compare the elements in the SortedSet to those of parameter 'o' and return
+// true if both sets are of equal length and contain all of the same elements
+func (s *SortedSet[T]) Equal(o *SortedSet[T]) bool {
+	if s.Len() != o.Len() {
+		return false
+	}
+	next, stop := iter.Pull(o.All())
+	defer stop()
+	for el := range s.All() {
+		v, _ := next()
+		if !s.equiv(el, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return a clone of the SortedSet
+func (s *SortedSet[T]) Clone() *SortedSet[T] {
+	return &SortedSet[T]{s.m.Clone()}
+}
+
+// Returns the smallest element unless the set is empty, then it returns a
+// default initialized value and false
+func (s *SortedSet[T]) Min() (T, bool) {
+	return s.m.Min()
+}
+
+// Returns the largest element unless the set is empty, then it returns a
+// default initialized value and false
+func (s *SortedSet[T]) Max() (T, bool) {
+	return s.m.Max()
+}
+
+// Returns the largest element less than or equal to el if there is one,
+// otherwise returns a default initialized value and false
+func (s *SortedSet[T]) Floor(el T) (T, bool) {
+	return s.m.Floor(el)
+}
+
+// Returns the smallest element greater than or equal to el if there is one,
+// otherwise returns a default initialized value and false
+func (s *SortedSet[T]) Ceiling(el T) (T, bool) {
+	return s.m.Ceiling(el)
+}
+
+// Returns the largest element strictly less than el if there is one, otherwise
+// returns a default initialized value and false
+func (s *SortedSet[T]) Lower(el T) (T, bool) {
+	return s.m.Lower(el)
+}
+
+// Returns the smallest element strictly greater than el if there is one,
+// otherwise returns a default initialized value and false
+func (s *SortedSet[T]) Higher(el T) (T, bool) {
+	return s.m.Higher(el)
+}
+
+// Return the element at position index in sorted order. If the index is out of
+// bounds then return a default initialized value and false.
+func (s *SortedSet[T]) At(index int) (T, bool) {
+	return s.m.KeyAt(index)
+}
+
+// Returns the number of elements in the set that are less than el
+func (s *SortedSet[T]) Rank(el T) int {
+	return s.m.Rank(el)
+}
+
+// Return an iterator over the elements in sorted order
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for el := range s.m.All() {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator over the elements in reverse sorted order
+func (s *SortedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for el := range s.m.Backward() {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator over the elements with lo <= el < hi in sorted order
+func (s *SortedSet[T]) Between(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for el := range s.m.Between(lo, hi) {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Run the function f against each element of the SortedSet in sorted order
+func (s *SortedSet[T]) ForEach(f func(T)) {
+	for el := range s.m.All() {
+		f(el)
+	}
+}
+
+// Merge the sorted elements of a and b in O(len(a) + len(b)) into a new set
+// ordered like a. keepA, keepB and keepBoth select which elements are kept when
+// they appear only in a, only in b or in both.
+func mergeSortedSets[T any](a, b *SortedSet[T], keepA, keepB, keepBoth bool) *SortedSet[T] {
+	comp := a.m.comp
+	r := make([]T, 0, a.Len()+b.Len())
+	nextB, stop := iter.Pull(b.All())
+	defer stop()
+	vb, okB := nextB()
+	for va := range a.All() {
+		for okB && comp(vb, va) {
+			if keepB {
+				r = append(r, vb)
+			}
+			vb, okB = nextB()
+		}
+		if okB && !comp(va, vb) { // equivalent
+			if keepBoth {
+				r = append(r, va)
+			}
+			vb, okB = nextB()
+		} else if keepA {
+			r = append(r, va)
+		}
+	}
+	for ; okB && keepB; vb, okB = nextB() {
+		r = append(r, vb)
+	}
+	return a.fromSorted(r)
+}
+
+// Return a new SortedSet containing the elements in either set a or set b or
+// both. Both sets must share the same ordering.
+func SortedSetUnion[T any](a, b *SortedSet[T]) *SortedSet[T] {
+	return mergeSortedSets(a, b, true, true, true)
+}
+
+// Return a new SortedSet containing the elements in both set a and set b. Both
+// sets must share the same ordering.
+func SortedSetIntersection[T any](a, b *SortedSet[T]) *SortedSet[T] {
+	return mergeSortedSets(a, b, false, false, true)
+}
+
+// Return a new SortedSet containing the elements from set a that are not in
+// set b. Both sets must share the same ordering.
+func SortedSetDifference[T any](a, b *SortedSet[T]) *SortedSet[T] {
+	return mergeSortedSets(a, b, true, false, false)
+}
+
+// Return a new SortedSet containing the elements from set a or set b, but not
+// both. Both sets must share the same ordering.
+func SortedSetSymmetricDifference[T any](a, b *SortedSet[T]) *SortedSet[T] {
+	return mergeSortedSets(a, b, true, true, false)
+}
@@ -0,0 +1,118 @@
+package vessels
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+func TestNewSortedSet(t *testing.T) {
+	s := NewSortedSet[int]()
+	expect(t, s.Len(), 0)
+	s = NewSortedSet(3, 1, 2, 1)
+	expect(t, s.Len(), 3)
+	expect(t, s.Keys(), []int{1, 2, 3})
+}
+
+func TestSortedSetContains(t *testing.T) {
+	s := NewSortedSet(1, 2, 3, 4)
+	expect(t, s.Contains(2), true)
+	expect(t, s.Contains(9), false)
+	expect(t, s.ContainsAll(2, 3), true)
+	expect(t, s.ContainsAll(4, 5), false)
+	expect(t, s.ContainsAny(4, 9), true)
+	expect(t, s.ContainsAny(9), false)
+}
+
+func TestSortedSetAddDelete(t *testing.T) {
+	s := NewSortedSet[int]()
+	s.Add(9)
+	s.Append(3, 5, 9)
+	expect(t, s.Values(), []int{3, 5, 9})
+	s.Delete(5)
+	expect(t, s.Keys(), []int{3, 9})
+	s.Clear()
+	expect(t, s.Len(), 0)
+}
+
+func TestSortedSetEqual(t *testing.T) {
+	a := NewSortedSet(1, 2, 3)
+	expect(t, a.Equal(NewSortedSet(3, 2, 1)), true)
+	expect(t, a.Equal(NewSortedSet(1, 2, 4)), false)
+	expect(t, a.Equal(NewSortedSet(1, 2)), false)
+}
+
+func TestSortedSetClone(t *testing.T) {
+	a := NewSortedSet(1, 2, 3)
+	b := a.Clone()
+	b.Add(4)
+	expect(t, a.Keys(), []int{1, 2, 3})
+	expect(t, b.Keys(), []int{1, 2, 3, 4})
+}
+
+func TestSortedSetQueries(t *testing.T) {
+	x := expected.New(t)
+	s := NewSortedSet(10, 20, 30)
+	x.ExpectOk(s.Min()).ToBe(10)
+	x.ExpectOk(s.Max()).ToBe(30)
+	x.ExpectOk(s.Floor(25)).ToBe(20)
+	x.ExpectOk(s.Ceiling(25)).ToBe(30)
+	x.ExpectOk(s.Lower(20)).ToBe(10)
+	x.ExpectOk(s.Higher(20)).ToBe(30)
+	x.ExpectNotOk(s.Higher(30))
+	x.ExpectOk(s.At(1)).ToBe(20)
+	x.Expect(s.Rank(30)).ToBe(2)
+	x.ExpectNotOk(NewSortedSet[int]().Min())
+}
+
+func TestSortedSetIterators(t *testing.T) {
+	s := NewSortedSet(5, 1, 4, 2, 3)
+	expect(t, slices.Collect(s.All()), []int{1, 2, 3, 4, 5})
+	expect(t, slices.Collect(s.Backward()), []int{5, 4, 3, 2, 1})
+	expect(t, slices.Collect(s.Between(2, 4)), []int{2, 3})
+	sum := 0
+	s.ForEach(func(v int) { sum += v })
+	expect(t, sum, 15)
+}
+
+func TestSortedSetFunc(t *testing.T) {
+	s := NewSortedSetFunc(func(a, b int) bool { return a > b }, 1, 3, 2)
+	expect(t, s.Keys(), []int{3, 2, 1})
+	u := SortedSetUnion(s, NewSortedSetFunc(func(a, b int) bool { return a > b }, 4, 0))
+	expect(t, u.Keys(), []int{4, 3, 2, 1, 0})
+}
+
+func TestSortedSetUnion(t *testing.T) {
+	a := NewSortedSet(1, 3, 5, 7)
+	b := NewSortedSet(2, 3, 4, 8, 9)
+	u := SortedSetUnion(a, b)
+	expect(t, u.Keys(), []int{1, 2, 3, 4, 5, 7, 8, 9})
+	checkTreeNode(t, u.m, u.m.root)
+	expect(t, SortedSetUnion(a, NewSortedSet[int]()).Keys(), []int{1, 3, 5, 7})
+	expect(t, SortedSetUnion(NewSortedSet[int](), b).Keys(), []int{2, 3, 4, 8, 9})
+}
+
+func TestSortedSetIntersection(t *testing.T) {
+	a := NewSortedSet(1, 3, 5, 7, 9)
+	b := NewSortedSet(2, 3, 4, 7, 9, 10)
+	expect(t, SortedSetIntersection(a, b).Keys(), []int{3, 7, 9})
+	expect(t, SortedSetIntersection(a, NewSortedSet(2, 4)).Len(), 0)
+}
+
+func TestSortedSetDifference(t *testing.T) {
+	a := NewSortedSet(1, 3, 5, 7, 9)
+	b := NewSortedSet(2, 3, 4, 7, 10)
+	expect(t, SortedSetDifference(a, b).Keys(), []int{1, 5, 9})
+	expect(t, SortedSetDifference(b, a).Keys(), []int{2, 4, 10})
+}
+
+func TestSortedSetSymmetricDifference(t *testing.T) {
+	a := NewSortedSet(1, 3, 5, 7, 9)
+	b := NewSortedSet(2, 3, 4, 7, 10)
+	r := SortedSetSymmetricDifference(a, b)
+	expect(t, r.Keys(), []int{1, 2, 4, 5, 9, 10})
+	r.Add(6) // result is a usable set
+	expect(t, r.Contains(6), true)
+	checkTreeNode(t, r.m, r.m.root)
+}
@@ -1,5 +1,7 @@
 package vessels
 
+import "iter"
+
 type Stack[T any] Deque[T]
 
 func NewStack[T any](size ...int) *Stack[T] {
@@ -33,3 +35,19 @@ func (s *Stack[T]) Clear() {
 func (s *Stack[T]) Clone() *Stack[T] {
 	return (*Stack[T])((*Deque[T])(s).Clone())
 }
+
+func CollectStack[T any](seq iter.Seq[T]) *Stack[T] {
+	return (*Stack[T])(CollectDeque(seq))
+}
+
+func (s *Stack[T]) All() iter.Seq2[int, T] {
+	return (*Deque[T])(s).All()
+}
+
+func (s *Stack[T]) Backward() iter.Seq2[int, T] {
+	return (*Deque[T])(s).Backward()
+}
+
+func (s *Stack[T]) Values() iter.Seq[T] {
+	return (*Deque[T])(s).Values()
+}
@@ -1,6 +1,7 @@
 package vessels
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/clayessex/algo/expected"
@@ -88,3 +89,19 @@ func TestStackClone(t *testing.T) {
 	x.Expect(c.Len()).ToBe(0)
 	x.Expect(s.Len()).ToBe(3)
 }
+
+func TestStackIterators(t *testing.T) {
+	s := CollectStack(slices.Values([]int{9, 8, 7}))
+	expect(t, s.Len(), 3)
+	expect(t, slices.Collect(s.Values()), []int{9, 8, 7})
+	values := []int{}
+	for _, v := range s.Backward() {
+		values = append(values, v)
+	}
+	expect(t, values, []int{7, 8, 9}) // pop order
+	indexes := []int{}
+	for i := range s.All() {
+		indexes = append(indexes, i)
+	}
+	expect(t, indexes, []int{0, 1, 2})
+}
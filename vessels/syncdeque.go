@@ -0,0 +1,304 @@
+package vessels
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// Returned when pushing to a closed container or popping from one that is
+	// both closed and empty
+	ErrClosed = errors.New("vessels: container is closed")
+
+	// Returned when pushing to a bounded container that is full without waiting
+	ErrFull = errors.New("vessels: container is full")
+)
+
+// SyncDeque is a Deque that is safe for concurrent use by multiple producers
+// and consumers. It may be bounded to a maximum length, in which case the
+// ...Wait push functions block until there is room. Pop functions can wait for
+// an element to arrive. After Close no more elements may be pushed, but the
+// remaining elements can still be popped.
+type SyncDeque[T any] struct {
+	mu      sync.Mutex
+	d       *Deque[T]
+	limit   int // maximum length, 0 for unbounded
+	closed  bool
+	waiters int           // goroutines blocked on changed
+	changed chan struct{} // closed and replaced when the deque changes
+}
+
+// Create a new SyncDeque holding at most limit elements. A limit of 0 or less
+// makes the SyncDeque unbounded.
+func NewSyncDeque[T any](limit int) *SyncDeque[T] {
+	limit = max(limit, 0)
+	size := INITIAL_DEQUE_SIZE
+	if limit > 0 {
+		size = min(limit, size)
+	}
+	return &SyncDeque[T]{
+		d:       NewDeque[T](size),
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// wake every blocked goroutine so it can recheck the deque, called with s.mu
+// held
+func (s *SyncDeque[T]) broadcast() {
+	if s.waiters > 0 {
+		close(s.changed)
+		s.changed = make(chan struct{})
+	}
+}
+
+// block until the deque changes or ctx is done, called with s.mu held
+func (s *SyncDeque[T]) wait(ctx context.Context) error {
+	ch := s.changed
+	s.waiters++
+	s.mu.Unlock()
+	var err error
+	select {
+	case <-ch:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	s.mu.Lock()
+	s.waiters--
+	return err
+}
+
+// push v onto the back or front, optionally waiting for room
+func (s *SyncDeque[T]) push(ctx context.Context, v T, back, wait bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if s.closed {
+			return ErrClosed
+		}
+		if s.limit == 0 || s.d.Len() < s.limit {
+			break
+		}
+		if !wait {
+			return ErrFull
+		}
+		if err := s.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if back {
+		s.d.PushBack(v)
+	} else {
+		s.d.PushFront(v)
+	}
+	s.broadcast()
+	return nil
+}
+
+// pop from the back or front, optionally waiting for an element. Returns
+// ErrClosed when closed and empty, or false with a nil error when empty and not
+// waiting
+func (s *SyncDeque[T]) pop(ctx context.Context, back, wait bool) (T, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.d.Len() == 0 {
+		var zero T
+		if s.closed {
+			return zero, false, ErrClosed
+		}
+		if !wait {
+			return zero, false, nil
+		}
+		if err := s.wait(ctx); err != nil {
+			return zero, false, err
+		}
+	}
+	var v T
+	if back {
+		v, _ = s.d.PopBack()
+	} else {
+		v, _ = s.d.PopFront()
+	}
+	s.broadcast()
+	return v, true, nil
+}
+
+// Number of elements currently held
+func (s *SyncDeque[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.d.Len()
+}
+
+// Maximum number of elements, 0 when unbounded
+func (s *SyncDeque[T]) Limit() int {
+	return s.limit
+}
+
+// Append to the back without blocking. Returns ErrFull if the SyncDeque is at
+// its limit or ErrClosed if it has been closed
+func (s *SyncDeque[T]) PushBack(v T) error {
+	return s.push(context.Background(), v, true, false)
+}
+
+// Insert before the front without blocking. Returns ErrFull if the SyncDeque is
+// at its limit or ErrClosed if it has been closed
+func (s *SyncDeque[T]) PushFront(v T) error {
+	return s.push(context.Background(), v, false, false)
+}
+
+// Append to the back, blocking while the SyncDeque is full. Returns ErrClosed
+// if it is closed or ctx.Err() if ctx is done before there is room
+func (s *SyncDeque[T]) PushBackWait(ctx context.Context, v T) error {
+	return s.push(ctx, v, true, true)
+}
+
+// Insert before the front, blocking while the SyncDeque is full. Returns
+// ErrClosed if it is closed or ctx.Err() if ctx is done before there is room
+func (s *SyncDeque[T]) PushFrontWait(ctx context.Context, v T) error {
+	return s.push(ctx, v, false, true)
+}
+
+// Remove and return the last element without blocking unless the SyncDeque is
+// empty, then it returns a default initialized value and false
+func (s *SyncDeque[T]) TryPopBack() (T, bool) {
+	v, ok, _ := s.pop(context.Background(), true, false)
+	return v, ok
+}
+
+// Remove and return the first element without blocking unless the SyncDeque is
+// empty, then it returns a default initialized value and false
+func (s *SyncDeque[T]) TryPopFront() (T, bool) {
+	v, ok, _ := s.pop(context.Background(), false, false)
+	return v, ok
+}
+
+// Remove and return the last element, blocking while the SyncDeque is empty.
+// Returns ErrClosed once it is closed and drained or ctx.Err() if ctx is done
+// before an element arrives
+func (s *SyncDeque[T]) PopBackWait(ctx context.Context) (T, error) {
+	v, _, err := s.pop(ctx, true, true)
+	return v, err
+}
+
+// Remove and return the first element, blocking while the SyncDeque is empty.
+// Returns ErrClosed once it is closed and drained or ctx.Err() if ctx is done
+// before an element arrives
+func (s *SyncDeque[T]) PopFrontWait(ctx context.Context) (T, error) {
+	v, _, err := s.pop(ctx, false, true)
+	return v, err
+}
+
+// Remove all elements, waking any blocked pushers
+func (s *SyncDeque[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.d.Clear()
+	s.broadcast()
+}
+
+// Close the SyncDeque to further pushes and wake every blocked goroutine.
+// Elements already held can still be popped. Closing more than once has no
+// effect.
+func (s *SyncDeque[T]) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		s.broadcast()
+	}
+}
+
+// True once Close has been called
+func (s *SyncDeque[T]) Closed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// SyncQueue is a first in, first out SyncDeque
+type SyncQueue[T any] SyncDeque[T]
+
+func NewSyncQueue[T any](limit int) *SyncQueue[T] {
+	return (*SyncQueue[T])(NewSyncDeque[T](limit))
+}
+
+func (q *SyncQueue[T]) Len() int {
+	return (*SyncDeque[T])(q).Len()
+}
+
+func (q *SyncQueue[T]) Limit() int {
+	return (*SyncDeque[T])(q).Limit()
+}
+
+func (q *SyncQueue[T]) Push(v T) error {
+	return (*SyncDeque[T])(q).PushBack(v)
+}
+
+func (q *SyncQueue[T]) PushWait(ctx context.Context, v T) error {
+	return (*SyncDeque[T])(q).PushBackWait(ctx, v)
+}
+
+func (q *SyncQueue[T]) TryPop() (T, bool) {
+	return (*SyncDeque[T])(q).TryPopFront()
+}
+
+func (q *SyncQueue[T]) PopWait(ctx context.Context) (T, error) {
+	return (*SyncDeque[T])(q).PopFrontWait(ctx)
+}
+
+func (q *SyncQueue[T]) Clear() {
+	(*SyncDeque[T])(q).Clear()
+}
+
+func (q *SyncQueue[T]) Close() {
+	(*SyncDeque[T])(q).Close()
+}
+
+func (q *SyncQueue[T]) Closed() bool {
+	return (*SyncDeque[T])(q).Closed()
+}
+
+// SyncStack is a last in, first out SyncDeque
+type SyncStack[T any] SyncDeque[T]
+
+func NewSyncStack[T any](limit int) *SyncStack[T] {
+	return (*SyncStack[T])(NewSyncDeque[T](limit))
+}
+
+func (s *SyncStack[T]) Len() int {
+	return (*SyncDeque[T])(s).Len()
+}
+
+func (s *SyncStack[T]) Limit() int {
+	return (*SyncDeque[T])(s).Limit()
+}
+
+func (s *SyncStack[T]) Push(v T) error {
+	return (*SyncDeque[T])(s).PushBack(v)
+}
+
+func (s *SyncStack[T]) PushWait(ctx context.Context, v T) error {
+	return (*SyncDeque[T])(s).PushBackWait(ctx, v)
+}
+
+func (s *SyncStack[T]) TryPop() (T, bool) {
+	return (*SyncDeque[T])(s).TryPopBack()
+}
+
+func (s *SyncStack[T]) PopWait(ctx context.Context) (T, error) {
+	return (*SyncDeque[T])(s).PopBackWait(ctx)
+}
+
+func (s *SyncStack[T]) Clear() {
+	(*SyncDeque[T])(s).Clear()
+}
+
+func (s *SyncStack[T]) Close() {
+	(*SyncDeque[T])(s).Close()
+}
+
+func (s *SyncStack[T]) Closed() bool {
+	return (*SyncDeque[T])(s).Closed()
+}
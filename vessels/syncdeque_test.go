@@ -0,0 +1,231 @@
+package vessels
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/clayessex/algo/expected"
+)
+
+func TestNewSyncDeque(t *testing.T) {
+	s := NewSyncDeque[int](0)
+	expect(t, s.Len(), 0)
+	expect(t, s.Limit(), 0)
+	expect(t, NewSyncDeque[int](-1).Limit(), 0)
+	expect(t, NewSyncDeque[int](4).Limit(), 4)
+}
+
+func TestSyncDequePushPop(t *testing.T) {
+	x := expected.New(t)
+	s := NewSyncDeque[int](0)
+	x.Expect(s.PushBack(8)).ToBe(nil)
+	x.Expect(s.PushFront(9)).ToBe(nil)
+	x.Expect(s.PushBack(7)).ToBe(nil)
+	x.Expect(s.Len()).ToBe(3)
+	x.ExpectOk(s.TryPopFront()).ToBe(9)
+	x.ExpectOk(s.TryPopBack()).ToBe(7)
+	x.ExpectOk(s.TryPopBack()).ToBe(8)
+	x.ExpectNotOk(s.TryPopFront())
+	x.ExpectNotOk(s.TryPopBack())
+}
+
+func TestSyncDequeLimit(t *testing.T) {
+	x := expected.New(t)
+	s := NewSyncDeque[int](2)
+	x.Expect(s.PushBack(1)).ToBe(nil)
+	x.Expect(s.PushFront(2)).ToBe(nil)
+	x.Expect(s.PushBack(3)).ToBe(ErrFull)
+	x.Expect(s.PushFront(3)).ToBe(ErrFull)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	x.Expect(s.PushBackWait(ctx, 3)).ToBe(context.DeadlineExceeded)
+	x.Expect(s.Len()).ToBe(2)
+}
+
+func TestSyncDequeWaitTimeout(t *testing.T) {
+	s := NewSyncDeque[int](0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.PopFrontWait(ctx)
+	expect(t, err, context.Canceled)
+	_, err = s.PopBackWait(ctx)
+	expect(t, err, context.Canceled)
+
+	s.PushBack(1) // available elements win over a done context
+	v, err := s.PopBackWait(ctx)
+	expect(t, v, 1)
+	expect(t, err, nil)
+}
+
+func TestSyncDequePushWait(t *testing.T) {
+	s := NewSyncDeque[int](1)
+	s.PushBack(1)
+	done := make(chan error)
+	go func() {
+		done <- s.PushFrontWait(context.Background(), 2)
+	}()
+	time.Sleep(5 * time.Millisecond)
+	v, _ := s.TryPopBack()
+	expect(t, v, 1)
+	expect(t, <-done, nil)
+	v, _ = s.TryPopBack()
+	expect(t, v, 2)
+}
+
+func TestSyncDequePopWait(t *testing.T) {
+	s := NewSyncDeque[string](0)
+	done := make(chan string)
+	go func() {
+		v, _ := s.PopFrontWait(context.Background())
+		done <- v
+	}()
+	time.Sleep(5 * time.Millisecond)
+	s.PushBack("a")
+	expect(t, <-done, "a")
+}
+
+func TestSyncDequeClose(t *testing.T) {
+	x := expected.New(t)
+	s := NewSyncDeque[int](1)
+	s.PushBack(1)
+
+	blocked := make(chan error)
+	go func() {
+		blocked <- s.PushBackWait(context.Background(), 2)
+	}()
+	time.Sleep(5 * time.Millisecond)
+	s.Close()
+	s.Close()
+	x.Expect(<-blocked).ToBe(ErrClosed)
+	x.Expect(s.Closed()).ToBe(true)
+	x.Expect(s.PushBack(3)).ToBe(ErrClosed)
+
+	v, err := s.PopFrontWait(context.Background()) // drain
+	x.Expect(v).ToBe(1)
+	x.Expect(err).ToBe(nil)
+	_, err = s.PopFrontWait(context.Background())
+	x.Expect(err).ToBe(ErrClosed)
+	x.ExpectNotOk(s.TryPopBack())
+}
+
+func TestSyncDequeCloseWakesPoppers(t *testing.T) {
+	s := NewSyncDeque[int](0)
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = s.PopBackWait(context.Background())
+		}()
+	}
+	time.Sleep(5 * time.Millisecond)
+	s.Close()
+	wg.Wait()
+	expect(t, errs, []error{ErrClosed, ErrClosed, ErrClosed, ErrClosed})
+}
+
+func TestSyncDequeClear(t *testing.T) {
+	s := NewSyncDeque[int](1)
+	s.PushBack(1)
+	done := make(chan error)
+	go func() {
+		done <- s.PushBackWait(context.Background(), 2)
+	}()
+	time.Sleep(5 * time.Millisecond)
+	s.Clear()
+	expect(t, <-done, nil)
+	expect(t, s.Len(), 1)
+}
+
+func TestSyncQueueConcurrent(t *testing.T) {
+	const producers, consumers, count = 4, 4, 500
+	q := NewSyncQueue[int](8)
+	ctx := context.Background()
+
+	var pwg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		pwg.Add(1)
+		go func() {
+			defer pwg.Done()
+			for i := 0; i < count; i++ {
+				if err := q.PushWait(ctx, p*count+i); err != nil {
+					t.Errorf("push: %v", err)
+				}
+			}
+		}()
+	}
+
+	var mu sync.Mutex
+	var cwg sync.WaitGroup
+	got := []int{}
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func() {
+			defer cwg.Done()
+			for {
+				v, err := q.PopWait(ctx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				got = append(got, v)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	pwg.Wait()
+	q.Close()
+	cwg.Wait()
+	slices.Sort(got)
+	want := make([]int, producers*count)
+	for i := range want {
+		want[i] = i
+	}
+	expect(t, got, want)
+	expect(t, q.Len(), 0)
+}
+
+func TestSyncQueue(t *testing.T) {
+	x := expected.New(t)
+	q := NewSyncQueue[int](2)
+	x.Expect(q.Limit()).ToBe(2)
+	x.Expect(q.Push(1)).ToBe(nil)
+	x.Expect(q.PushWait(context.Background(), 2)).ToBe(nil)
+	x.Expect(q.Push(3)).ToBe(ErrFull)
+	x.Expect(q.Len()).ToBe(2)
+	x.ExpectOk(q.TryPop()).ToBe(1)
+	v, err := q.PopWait(context.Background())
+	x.Expect([]any{v, err}).ToBe([]any{2, nil})
+	q.Push(4)
+	q.Clear()
+	x.ExpectNotOk(q.TryPop())
+	q.Close()
+	x.Expect(q.Closed()).ToBe(true)
+	x.Expect(q.Push(5)).ToBe(ErrClosed)
+}
+
+func TestSyncStack(t *testing.T) {
+	x := expected.New(t)
+	s := NewSyncStack[int](2)
+	x.Expect(s.Limit()).ToBe(2)
+	x.Expect(s.Push(1)).ToBe(nil)
+	x.Expect(s.PushWait(context.Background(), 2)).ToBe(nil)
+	x.Expect(s.Push(3)).ToBe(ErrFull)
+	x.Expect(s.Len()).ToBe(2)
+	x.ExpectOk(s.TryPop()).ToBe(2)
+	v, err := s.PopWait(context.Background())
+	x.Expect([]any{v, err}).ToBe([]any{1, nil})
+	s.Push(4)
+	s.Clear()
+	x.ExpectNotOk(s.TryPop())
+	s.Close()
+	x.Expect(s.Closed()).ToBe(true)
+	_, err = s.PopWait(context.Background())
+	x.Expect(err).ToBe(ErrClosed)
+}
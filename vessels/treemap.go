@@ -0,0 +1,507 @@
+package vessels
+
+import (
+	"cmp"
+	"iter"
+)
+
+// TreeMap is a map that keeps its keys sorted. It is implemented as an AVL
+// tree with subtree sizes so that lookups, insertion, deletion and positional
+// access (At, Rank) are all O(log N). The method names match OrderedMap so the
+// two can be used interchangeably, with key order replacing insertion order.
+type TreeMap[K any, V any] struct {
+	root *treeNode[K, V]
+	comp func(a, b K) bool // returns true if a is ordered before b
+}
+
+// A single key/value pair of a TreeMap
+type treeNode[K any, V any] struct {
+	left   *treeNode[K, V]
+	right  *treeNode[K, V]
+	key    K
+	value  V
+	height int // height of the subtree rooted here, 1 for a leaf
+	size   int // number of nodes in the subtree rooted here
+}
+
+// Create a new TreeMap ordered by cmp.Less
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Less[K])
+}
+
+// Create a new TreeMap ordered by comp
+// comp is a comparison function that returns true if a is ordered before b
+func NewTreeMapFunc[K any, V any](comp func(a, b K) bool) *TreeMap[K, V] {
+	return &TreeMap[K, V]{nil, comp}
+}
+
+func (n *treeNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[K, V]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// recalculate height and size from the children
+func (n *treeNode[K, V]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.size = 1 + n.left.getSize() + n.right.getSize()
+}
+
+func (n *treeNode[K, V]) rotateLeft() *treeNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *treeNode[K, V]) rotateRight() *treeNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// restore the AVL balance of n after one of its subtrees changed height by at
+// most one and return the new subtree root
+func (n *treeNode[K, V]) rebalance() *treeNode[K, V] {
+	n.update()
+	switch bf := n.left.getHeight() - n.right.getHeight(); {
+	case bf > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case bf < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// build a balanced subtree from keys that are already sorted and unique in
+// O(n). values holds the value for each key or is nil for zero values
+func buildTreeNodes[K any, V any](keys []K, values []V) *treeNode[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	mid := len(keys) / 2
+	n := &treeNode[K, V]{key: keys[mid]}
+	if values != nil {
+		n.value = values[mid]
+		n.left = buildTreeNodes(keys[:mid], values[:mid])
+		n.right = buildTreeNodes(keys[mid+1:], values[mid+1:])
+	} else {
+		n.left = buildTreeNodes[K, V](keys[:mid], nil)
+		n.right = buildTreeNodes[K, V](keys[mid+1:], nil)
+	}
+	n.update()
+	return n
+}
+
+// copy the subtree rooted at n
+func (n *treeNode[K, V]) clone() *treeNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left = n.left.clone()
+	c.right = n.right.clone()
+	return &c
+}
+
+// in-order traversal, returns false if yield stopped the iteration
+func (n *treeNode[K, V]) all(yield func(K, V) bool) bool {
+	return n == nil ||
+		(n.left.all(yield) && yield(n.key, n.value) && n.right.all(yield))
+}
+
+// reverse in-order traversal, returns false if yield stopped the iteration
+func (n *treeNode[K, V]) backward(yield func(K, V) bool) bool {
+	return n == nil ||
+		(n.right.backward(yield) && yield(n.key, n.value) && n.left.backward(yield))
+}
+
+// insert or overwrite key, returns the new subtree root and true if a node was
+// added
+func (m *TreeMap[K, V]) insert(n *treeNode[K, V], key K, value V) (*treeNode[K, V], bool) {
+	if n == nil {
+		return &treeNode[K, V]{key: key, value: value, height: 1, size: 1}, true
+	}
+	var added bool
+	if m.comp(key, n.key) {
+		n.left, added = m.insert(n.left, key, value)
+	} else if m.comp(n.key, key) {
+		n.right, added = m.insert(n.right, key, value)
+	} else {
+		n.value = value
+		return n, false
+	}
+	return n.rebalance(), added
+}
+
+// remove the smallest node of the subtree, returns the new subtree root and the
+// removed node
+func (m *TreeMap[K, V]) deleteMin(n *treeNode[K, V]) (*treeNode[K, V], *treeNode[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var least *treeNode[K, V]
+	n.left, least = m.deleteMin(n.left)
+	return n.rebalance(), least
+}
+
+// remove key, returns the new subtree root and true if a node was removed
+func (m *TreeMap[K, V]) delete(n *treeNode[K, V], key K) (*treeNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	if m.comp(key, n.key) {
+		n.left, removed = m.delete(n.left, key)
+	} else if m.comp(n.key, key) {
+		n.right, removed = m.delete(n.right, key)
+	} else {
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		var successor *treeNode[K, V]
+		n.right, successor = m.deleteMin(n.right)
+		successor.left, successor.right = n.left, n.right
+		return successor.rebalance(), true
+	}
+	if !removed {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+// find the node for key or nil
+func (m *TreeMap[K, V]) find(key K) *treeNode[K, V] {
+	n := m.root
+	for n != nil {
+		if m.comp(key, n.key) {
+			n = n.left
+		} else if m.comp(n.key, key) {
+			n = n.right
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+// find the node at position index in key order or nil
+func (m *TreeMap[K, V]) selectNode(index int) *treeNode[K, V] {
+	if index < 0 || index >= m.Len() {
+		return nil
+	}
+	n := m.root
+	for n != nil {
+		ls := n.left.getSize()
+		if index < ls {
+			n = n.left
+		} else if index > ls {
+			index -= ls + 1
+			n = n.right
+		} else {
+			break
+		}
+	}
+	return n
+}
+
+// Returns the current length of the map
+func (m *TreeMap[K, V]) Len() int {
+	return m.root.getSize()
+}
+
+// Returns true if the TreeMap contains the given key
+func (m *TreeMap[K, V]) Contains(key K) bool {
+	return m.find(key) != nil
+}
+
+// Returns the value for the given key if the key exists, otherwise a default
+// initialized value and false
+func (m *TreeMap[K, V]) Value(key K) (V, bool) {
+	n := m.find(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Insert the key/value pair into the map (same as Push())
+func (m *TreeMap[K, V]) Insert(key K, value V) {
+	m.root, _ = m.insert(m.root, key, value)
+}
+
+// Delete the key/value pair for the given key
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	var removed bool
+	m.root, removed = m.delete(m.root, key)
+	return removed
+}
+
+// Push the key/value pair into the map (same as Insert())
+func (m *TreeMap[K, V]) Push(key K, value V) {
+	m.Insert(key, value)
+}
+
+// Pop the largest key from the map unless the map is empty and then it returns
+// a default initialized value and false
+func (m *TreeMap[K, V]) Pop() (K, bool) {
+	key, ok := m.Last()
+	if ok {
+		m.Delete(key)
+	}
+	return key, ok
+}
+
+// Returns the smallest key unless the map is empty, then it returns a default
+// initialized value and false
+func (m *TreeMap[K, V]) Min() (K, bool) {
+	n := m.root
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, true
+}
+
+// Returns the largest key unless the map is empty, then it returns a default
+// initialized value and false
+func (m *TreeMap[K, V]) Max() (K, bool) {
+	n := m.root
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, true
+}
+
+// Returns the smallest key (same as Min())
+func (m *TreeMap[K, V]) First() (K, bool) {
+	return m.Min()
+}
+
+// Returns the largest key (same as Max())
+func (m *TreeMap[K, V]) Last() (K, bool) {
+	return m.Max()
+}
+
+// Returns the largest key less than or equal to key if there is one, otherwise
+// returns a default initialized value and false
+func (m *TreeMap[K, V]) Floor(key K) (K, bool) {
+	var r *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.comp(key, n.key) {
+			n = n.left
+		} else {
+			r, n = n, n.right
+		}
+	}
+	return nodeKey(r)
+}
+
+// Returns the smallest key greater than or equal to key if there is one,
+// otherwise returns a default initialized value and false
+func (m *TreeMap[K, V]) Ceiling(key K) (K, bool) {
+	var r *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.comp(n.key, key) {
+			n = n.right
+		} else {
+			r, n = n, n.left
+		}
+	}
+	return nodeKey(r)
+}
+
+// Returns the largest key strictly less than key if there is one, otherwise
+// returns a default initialized value and false
+func (m *TreeMap[K, V]) Lower(key K) (K, bool) {
+	var r *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.comp(n.key, key) {
+			r, n = n, n.right
+		} else {
+			n = n.left
+		}
+	}
+	return nodeKey(r)
+}
+
+// Returns the smallest key strictly greater than key if there is one, otherwise
+// returns a default initialized value and false
+func (m *TreeMap[K, V]) Higher(key K) (K, bool) {
+	var r *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.comp(key, n.key) {
+			r, n = n, n.left
+		} else {
+			n = n.right
+		}
+	}
+	return nodeKey(r)
+}
+
+// key of n or a default initialized value and false if n is nil
+func nodeKey[K any, V any](n *treeNode[K, V]) (K, bool) {
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+	return n.key, true
+}
+
+// Returns the key following the given key if both exist, otherwise returns a
+// default initialized value and false
+func (m *TreeMap[K, V]) Next(key K) (K, bool) {
+	if !m.Contains(key) {
+		var zero K
+		return zero, false
+	}
+	return m.Higher(key)
+}
+
+// Returns the key preceding the given key if both exist, otherwise returns a
+// default initialized value and false
+func (m *TreeMap[K, V]) Prev(key K) (K, bool) {
+	if !m.Contains(key) {
+		var zero K
+		return zero, false
+	}
+	return m.Lower(key)
+}
+
+// Returns the number of keys in the map that are less than key. When key is in
+// the map this is its index in key order.
+func (m *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+	for n := m.root; n != nil; {
+		if m.comp(n.key, key) {
+			rank += n.left.getSize() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// Return the key at position index in key order. If the index is out of
+// bounds then return a default initialized value and false.
+func (m *TreeMap[K, V]) KeyAt(index int) (K, bool) {
+	return nodeKey(m.selectNode(index))
+}
+
+// Return the value at position index in key order. If the index is out of
+// bounds then return a default initialized value and false.
+func (m *TreeMap[K, V]) At(index int) (V, bool) {
+	n := m.selectNode(index)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Remove all key/value pairs from the map
+func (m *TreeMap[K, V]) Clear() {
+	m.root = nil
+}
+
+// Return a clone of the map sharing the same comparison function
+func (m *TreeMap[K, V]) Clone() *TreeMap[K, V] {
+	return &TreeMap[K, V]{m.root.clone(), m.comp}
+}
+
+// Return a slice containing all of the keys in key order
+func (m *TreeMap[K, V]) Keys() []K {
+	r := make([]K, 0, m.Len())
+	for k := range m.All() {
+		r = append(r, k)
+	}
+	return r
+}
+
+// Return a slice containing all of the values in key order
+func (m *TreeMap[K, V]) Values() []V {
+	r := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		r = append(r, v)
+	}
+	return r
+}
+
+// Iterate over the map in key order calling function f on each key/value pair
+func (m *TreeMap[K, V]) Range(f func(K, V)) {
+	for k, v := range m.All() {
+		f(k, v)
+	}
+}
+
+// Return an iterator over the key/value pairs in key order
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.all(yield)
+	}
+}
+
+// Return an iterator over the key/value pairs in reverse key order
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.backward(yield)
+	}
+}
+
+// Return an iterator over the key/value pairs with lo <= key < hi in key order
+func (m *TreeMap[K, V]) Between(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.between(m.root, lo, hi, yield)
+	}
+}
+
+// in-order traversal of [lo, hi) skipping subtrees outside of the range,
+// returns false if yield stopped the iteration
+func (m *TreeMap[K, V]) between(n *treeNode[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := !m.comp(n.key, lo) // lo <= n.key
+	belowHi := m.comp(n.key, hi)  // n.key < hi
+	if aboveLo && !m.between(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return m.between(n.right, lo, hi, yield)
+	}
+	return true
+}
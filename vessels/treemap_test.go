@@ -0,0 +1,243 @@
+package vessels
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/clayessex/algo/expected"
+)
+
+// verify the AVL balance, sizes and key order of every node
+func checkTreeNode[K any, V any](t *testing.T, m *TreeMap[K, V], n *treeNode[K, V]) {
+	t.Helper()
+	if n == nil {
+		return
+	}
+	checkTreeNode(t, m, n.left)
+	checkTreeNode(t, m, n.right)
+	if n.left != nil && !m.comp(n.left.key, n.key) ||
+		n.right != nil && !m.comp(n.key, n.right.key) {
+		t.Fatalf("%s failed: unordered node %v", t.Name(), n.key)
+	}
+	bf := n.left.getHeight() - n.right.getHeight()
+	if bf < -1 || bf > 1 {
+		t.Fatalf("%s failed: unbalanced node %v: %v", t.Name(), n.key, bf)
+	}
+	if n.height != 1+max(n.left.getHeight(), n.right.getHeight()) ||
+		n.size != 1+n.left.getSize()+n.right.getSize() {
+		t.Fatalf("%s failed: stale node %v", t.Name(), n.key)
+	}
+}
+
+func makeTestTreeMap(keys ...int) *TreeMap[int, int] {
+	m := NewTreeMap[int, int]()
+	for _, k := range keys {
+		m.Insert(k, k*10)
+	}
+	return m
+}
+
+func TestNewTreeMap(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	expect(t, m.Len(), 0)
+	f := NewTreeMapFunc[int, string](func(a, b int) bool { return a > b })
+	expect(t, f.Len(), 0)
+}
+
+func TestTMInsert(t *testing.T) {
+	x := expected.New(t)
+	m := NewTreeMap[string, int]()
+	m.Insert("c", 7)
+	m.Insert("a", 9)
+	m.Insert("b", 8)
+	x.Expect(m.Len()).ToBe(3)
+	x.ExpectOk(m.First()).ToBe("a")
+	x.ExpectOk(m.Last()).ToBe("c")
+	x.ExpectOk(m.Value("a")).ToBe(9)
+	m.Insert("a", 3)
+	x.Expect(m.Len()).ToBe(3)
+	x.ExpectOk(m.Value("a")).ToBe(3)
+	x.ExpectNotOk(m.Value("z"))
+	x.Expect(m.Contains("b")).ToBe(true)
+	x.Expect(m.Contains("z")).ToBe(false)
+}
+
+func TestTMDelete(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestTreeMap(5, 3, 8, 1, 4, 7, 9)
+	x.Expect(m.Delete(3)).ToBe(true)
+	x.Expect(m.Delete(3)).ToBe(false)
+	x.Expect(m.Delete(5)).ToBe(true) // root with two children
+	x.Expect(m.Len()).ToBe(5)
+	x.Expect(m.Keys()).ToBe([]int{1, 4, 7, 8, 9})
+	checkTreeNode(t, m, m.root)
+}
+
+func TestTMPushPop(t *testing.T) {
+	x := expected.New(t)
+	m := NewTreeMap[int, int]()
+	m.Push(2, 9)
+	m.Push(1, 8)
+	x.ExpectOk(m.Pop()).ToBe(2)
+	x.ExpectOk(m.Pop()).ToBe(1)
+	x.ExpectNotOk(m.Pop())
+}
+
+func TestTMMinMax(t *testing.T) {
+	x := expected.New(t)
+	m := NewTreeMap[int, int]()
+	x.ExpectNotOk(m.Min())
+	x.ExpectNotOk(m.Max())
+	m = makeTestTreeMap(5, 3, 8)
+	x.ExpectOk(m.Min()).ToBe(3)
+	x.ExpectOk(m.Max()).ToBe(8)
+}
+
+func TestTMFloorCeiling(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestTreeMap(10, 20, 30)
+	x.ExpectOk(m.Floor(20)).ToBe(20)
+	x.ExpectOk(m.Floor(25)).ToBe(20)
+	x.ExpectNotOk(m.Floor(5))
+	x.ExpectOk(m.Ceiling(20)).ToBe(20)
+	x.ExpectOk(m.Ceiling(15)).ToBe(20)
+	x.ExpectNotOk(m.Ceiling(35))
+	x.ExpectOk(m.Lower(20)).ToBe(10)
+	x.ExpectNotOk(m.Lower(10))
+	x.ExpectOk(m.Higher(20)).ToBe(30)
+	x.ExpectOk(m.Higher(5)).ToBe(10)
+	x.ExpectNotOk(m.Higher(30))
+}
+
+func TestTMNextPrev(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestTreeMap(1, 2, 3)
+	x.ExpectOk(m.Next(1)).ToBe(2)
+	x.ExpectNotOk(m.Next(3))
+	x.ExpectNotOk(m.Next(42))
+	x.ExpectOk(m.Prev(3)).ToBe(2)
+	x.ExpectNotOk(m.Prev(1))
+	x.ExpectNotOk(m.Prev(42))
+}
+
+func TestTMRankAt(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestTreeMap(50, 10, 40, 20, 30)
+	x.Expect(m.Rank(10)).ToBe(0)
+	x.Expect(m.Rank(30)).ToBe(2)
+	x.Expect(m.Rank(35)).ToBe(3)
+	x.Expect(m.Rank(99)).ToBe(5)
+	x.ExpectOk(m.KeyAt(0)).ToBe(10)
+	x.ExpectOk(m.KeyAt(4)).ToBe(50)
+	x.ExpectOk(m.At(2)).ToBe(300)
+	x.ExpectNotOk(m.At(5))
+	x.ExpectNotOk(m.KeyAt(-1))
+}
+
+func TestTMKeysValues(t *testing.T) {
+	m := makeTestTreeMap(3, 1, 2)
+	expect(t, m.Keys(), []int{1, 2, 3})
+	expect(t, m.Values(), []int{10, 20, 30})
+	expect(t, NewTreeMap[int, int]().Keys(), []int{})
+}
+
+func TestTMClearClone(t *testing.T) {
+	m := makeTestTreeMap(3, 1, 2)
+	c := m.Clone()
+	m.Clear()
+	expect(t, m.Len(), 0)
+	c.Insert(4, 40)
+	expect(t, c.Keys(), []int{1, 2, 3, 4})
+}
+
+func TestTMRange(t *testing.T) {
+	m := makeTestTreeMap(3, 1, 2)
+	keys := []int{}
+	values := []int{}
+	m.Range(func(k int, v int) {
+		keys = append(keys, k)
+		values = append(values, v)
+	})
+	expect(t, keys, []int{1, 2, 3})
+	expect(t, values, []int{10, 20, 30})
+}
+
+func TestTMIterators(t *testing.T) {
+	x := expected.New(t)
+	m := makeTestTreeMap(5, 1, 4, 2, 3)
+	keys := []int{}
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{5, 4, 3, 2, 1})
+
+	keys = keys[:0]
+	for k := range m.All() {
+		if k == 3 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{1, 2})
+
+	keys = keys[:0]
+	for k := range m.Between(2, 5) {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{2, 3, 4})
+
+	keys = keys[:0]
+	for k := range m.Between(0, 9) {
+		if k == 2 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{1})
+
+	keys = keys[:0]
+	for k := range m.Between(4, 2) {
+		keys = append(keys, k)
+	}
+	x.Expect(keys).ToBe([]int{})
+}
+
+func TestTMFunc(t *testing.T) {
+	m := NewTreeMapFunc[string, int](func(a, b string) bool { return a > b })
+	m.Insert("a", 1)
+	m.Insert("c", 3)
+	m.Insert("b", 2)
+	expect(t, m.Keys(), []string{"c", "b", "a"})
+	k, _ := m.Floor("bb") // largest key ordered at or before "bb"
+	expect(t, k, "c")
+}
+
+func TestTMRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewTreeMap[int, int]()
+	ref := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, had := ref[k]
+			delete(ref, k)
+			expect(t, m.Delete(k), had)
+		} else {
+			ref[k] = i
+			m.Insert(k, i)
+		}
+	}
+	checkTreeNode(t, m, m.root)
+	keys := make([]int, 0, len(ref))
+	for k := range ref {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	expect(t, m.Keys(), keys)
+	for i, k := range keys {
+		expect(t, m.Rank(k), i)
+		v, _ := m.At(i)
+		expect(t, v, ref[k])
+	}
+}
@@ -0,0 +1,92 @@
+package algo
+
+// Pair holds two values of possibly different types
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Create and return a new slice pairing a[i] with b[i]. The result has the
+// length of the shorter input and the extra elements of the longer one are
+// ignored; see ZipLongest. See seq.Zip for the lazy form.
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{x, y}
+	})
+}
+
+// Create and return two new slices holding the First and Second values of
+// each pair of p, the inverse of Zip
+func Unzip[A any, B any](p []Pair[A, B]) ([]A, []B) {
+	a, b := make([]A, len(p)), make([]B, len(p))
+	for i, v := range p {
+		a[i], b[i] = v.First, v.Second
+	}
+	return a, b
+}
+
+// Create and return a new slice filled with the results of f(a[i], b[i]). The
+// result has the length of the shorter input.
+func ZipWith[A any, B any, O any](a []A, b []B, f func(A, B) O) []O {
+	r := make([]O, min(len(a), len(b)))
+	for i := range r {
+		r[i] = f(a[i], b[i])
+	}
+	return r
+}
+
+// Create and return a new slice grouping a[i], b[i] and c[i]. The result has
+// the length of the shortest input.
+func Zip3[A any, B any, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	r := make([]Triple[A, B, C], min(len(a), len(b), len(c)))
+	for i := range r {
+		r[i] = Triple[A, B, C]{a[i], b[i], c[i]}
+	}
+	return r
+}
+
+// Create and return a new slice pairing a[i] with b[i] that has the length of
+// the longer input. Positions past the end of the shorter input are filled
+// with fillA or fillB.
+func ZipLongest[A any, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	r := make([]Pair[A, B], max(len(a), len(b)))
+	for i := range r {
+		r[i] = Pair[A, B]{fillA, fillB}
+		if i < len(a) {
+			r[i].First = a[i]
+		}
+		if i < len(b) {
+			r[i].Second = b[i]
+		}
+	}
+	return r
+}
+
+// Create and return the transpose of s, where element [j][i] of the result is
+// s[i][j]. Like Zip, ragged input is truncated to the length of its shortest
+// row, so the result has that many rows, each of length len(s). Returns an
+// empty slice if s is empty.
+func Transpose[T any](s [][]T) [][]T {
+	if len(s) == 0 {
+		return [][]T{}
+	}
+	cols := len(s[0])
+	for _, row := range s[1:] {
+		cols = min(cols, len(row))
+	}
+	r := make([][]T, cols)
+	for j := range r {
+		r[j] = make([]T, len(s))
+		for i, row := range s {
+			r[j][i] = row[j]
+		}
+	}
+	return r
+}
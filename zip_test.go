@@ -0,0 +1,51 @@
+package algo
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	p := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	expect(t, p, []Pair[int, string]{{1, "a"}, {2, "b"}})
+	p = Zip([]int{1}, []string{"a", "b"})
+	expect(t, p, []Pair[int, string]{{1, "a"}})
+	expect(t, Zip([]int{}, []string{"a"}), []Pair[int, string]{})
+
+	a, b := Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	expect(t, a, []int{1, 2})
+	expect(t, b, []string{"a", "b"})
+	a, b = Unzip([]Pair[int, string]{})
+	expect(t, a, []int{})
+	expect(t, b, []string{})
+}
+
+func TestZipWith(t *testing.T) {
+	r := ZipWith([]int{1, 2, 3}, []int{10, 20}, func(a, b int) string {
+		return strconv.Itoa(a + b)
+	})
+	expect(t, r, []string{"11", "22"})
+}
+
+func TestZip3(t *testing.T) {
+	r := Zip3([]int{1, 2, 3}, []string{"a", "b", "c"}, []bool{true, false})
+	expect(t, r, []Triple[int, string, bool]{{1, "a", true}, {2, "b", false}})
+	expect(t, len(Zip3([]int{1}, []int{}, []int{1})), 0)
+}
+
+func TestZipLongest(t *testing.T) {
+	p := ZipLongest([]int{1, 2, 3}, []string{"a"}, -1, "?")
+	expect(t, p, []Pair[int, string]{{1, "a"}, {2, "?"}, {3, "?"}})
+	p = ZipLongest([]int{1}, []string{"a", "b"}, -1, "?")
+	expect(t, p, []Pair[int, string]{{1, "a"}, {-1, "b"}})
+	expect(t, ZipLongest([]int{}, []string{}, 0, ""), []Pair[int, string]{})
+}
+
+func TestTranspose(t *testing.T) {
+	expect(t, Transpose([][]int{{1, 2, 3}, {4, 5, 6}}), [][]int{{1, 4}, {2, 5}, {3, 6}})
+	expect(t, Transpose([][]int{{1, 2, 3}, {4}, {5, 6}}), [][]int{{1, 4, 5}})
+	expect(t, Transpose([][]int{{1, 2}, {}}), [][]int{})
+	expect(t, Transpose([][]int{}), [][]int{})
+	m := [][]int{{1, 2}, {3, 4}, {5, 6}}
+	expect(t, Transpose(Transpose(m)), m)
+}